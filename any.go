@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// A path into a google.protobuf.Any may begin with the parenthesized full name of a packed message
+// type, followed by a path of that type's fields. For example, the path "details.*.(pkg.Foo).bar"
+// of a repeated Any field named details selects the bar field of every element that packs a pkg.Foo
+// message. An element that's selected this way is unpacked, masked, and packed again. An element
+// that packs another type is masked by the paths of the Any's own fields, if any. If the packed type
+// of an element can't be resolved, or its value can't be unmarshaled, the element is kept as is.
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+const (
+	anyTypeURLNumber protoreflect.FieldNumber = 1
+	anyValueNumber   protoreflect.FieldNumber = 2
+)
+
+// anyTypeName returns the full name of the message type named by the path segment
+// and a value indicating if the segment is a parenthesized type name.
+func anyTypeName(segment string) (protoreflect.FullName, bool) {
+	if len(segment) < 3 || segment[0] != '(' || segment[len(segment)-1] != ')' {
+		return "", false
+	}
+	return protoreflect.FullName(segment[1 : len(segment)-1]), true
+}
+
+// anySegment returns the path segment that names the message type.
+func anySegment(name protoreflect.FullName) string {
+	return "(" + string(name) + ")"
+}
+
+// isAny returns a value indicating if the message is a google.protobuf.Any.
+func (mm *msgMask) isAny() bool {
+	return mm.desc.FullName() == anyFullName
+}
+
+// addAnyPath adds the path to the mask of the packed message type named by its first segment.
+// It returns a value indicating if the message is a google.protobuf.Any and the path begins
+// with a type name, or else a nil error and a false value.
+func (mm *msgMask) addAnyPath(path string, init bool) (bool, error) {
+	if !mm.isAny() {
+		return false, nil
+	}
+	segment, subpath, err := nextSegment(path, mm.settings.pathSep)
+	if err != nil {
+		return false, nil
+	}
+	name, ok := anyTypeName(segment)
+	if !ok {
+		return false, nil
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return true, fmt.Errorf("unknown %v packed type: %q", mm.desc.FullName(), name)
+	}
+	if !init && mm.fields == nil {
+		return true, nil
+	}
+	if err := mm.settings.enterMessage(mm.desc); err != nil {
+		return true, err
+	}
+	defer mm.settings.leaveMessage()
+	if init {
+		mm.fields = make(map[string]fieldMask)
+	}
+	if sub, ok := mm.anyMasks[name]; ok {
+		return true, sub.append(subpath)
+	}
+	sub := newMsgMask(mm.settings, mt.Descriptor())
+	if err := sub.init(subpath); err != nil {
+		return true, err
+	}
+	if mm.anyMasks == nil {
+		mm.anyMasks = make(map[protoreflect.FullName]*msgMask)
+	}
+	mm.anyMasks[name] = sub
+	return true, nil
+}
+
+// anyPaths returns the paths of the masks of the packed message types.
+func (mm *msgMask) anyPaths() []string {
+	var paths []string
+	names := maps.Keys(mm.anyMasks)
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	for _, name := range names {
+		paths = appendSubpaths(paths, anySegment(name), mm.anyMasks[name].paths(), mm.settings.pathSep)
+	}
+	return paths
+}
+
+// anyMask returns the mask of the message type packed in the google.protobuf.Any, the unpacked
+// message, and a value indicating if the packed type has a mask or can't be resolved. The unpacked
+// message is nil if the packed type can't be resolved or its value can't be unmarshaled.
+func (mm *msgMask) anyMask(msg protoreflect.Message) (*msgMask, protoreflect.Message, bool) {
+	if mm.anyMasks == nil {
+		return nil, nil, false
+	}
+	sub, ok := mm.anyMasks[mm.packedName(msg)]
+	if !ok {
+		// A packed type that can't be resolved is kept as is, whether or not it has a mask.
+		url := msg.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)).String()
+		_, err := protoregistry.GlobalTypes.FindMessageByURL(url)
+		return nil, nil, url != "" && err != nil
+	}
+	return sub, mm.unpackAny(msg), true
+}
+
+// packedName returns the full name of the message type packed in the google.protobuf.Any.
+func (mm *msgMask) packedName(msg protoreflect.Message) protoreflect.FullName {
+	url := msg.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)).String()
+	if i := strings.LastIndexByte(url, '/'); i != -1 {
+		url = url[i+1:]
+	}
+	return protoreflect.FullName(url)
+}
+
+// unpackAny returns the message packed in the google.protobuf.Any, or nil if its type
+// can't be resolved or its value can't be unmarshaled.
+func (mm *msgMask) unpackAny(msg protoreflect.Message) protoreflect.Message {
+	url := msg.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)).String()
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(url)
+	if err != nil {
+		return nil
+	}
+	m := mt.New()
+	if err := proto.Unmarshal(msg.Get(mm.fldDescs.ByNumber(anyValueNumber)).Bytes(), m.Interface()); err != nil {
+		return nil
+	}
+	return m
+}
+
+// packAny sets the type URL and value of the google.protobuf.Any to those of the message.
+// It leaves the Any untouched if the message can't be marshaled.
+func (mm *msgMask) packAny(dst protoreflect.Message, url protoreflect.Value, m protoreflect.Message) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	if err != nil {
+		return
+	}
+	dst.Set(mm.fldDescs.ByNumber(anyTypeURLNumber), url)
+	dst.Set(mm.fldDescs.ByNumber(anyValueNumber), protoreflect.ValueOfBytes(b))
+}
+
+// copyAny replaces the type URL and value of the destination google.protobuf.Any with those of the source.
+func (mm *msgMask) copyAny(dst, src protoreflect.Message) {
+	for _, num := range []protoreflect.FieldNumber{anyTypeURLNumber, anyValueNumber} {
+		fd := mm.fldDescs.ByNumber(num)
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+}
+
+// maskAny masks the message packed in the google.protobuf.Any in place and returns a value
+// indicating if the packed type has a mask.
+func (mm *msgMask) maskAny(msg protoreflect.Message, path string, c *callState) bool {
+	sub, packed, ok := mm.anyMask(msg)
+	if !ok {
+		return false
+	}
+	if packed != nil {
+		sub.mask(packed, mm.settings.clearPath(path, anySegment(packed.Descriptor().FullName())), c)
+		mm.packAny(msg, msg.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)), packed)
+	}
+	return true
+}
+
+// cloneAny copies the masked message packed in the google.protobuf.Any into the empty output
+// message and returns a value indicating if the packed type has a mask.
+func (mm *msgMask) cloneAny(out, msg protoreflect.Message, c *callState) bool {
+	sub, packed, ok := mm.anyMask(msg)
+	if !ok {
+		return false
+	}
+	if packed == nil {
+		mm.copyAny(out, msg)
+		return true
+	}
+	mm.packAny(out, msg.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)), sub.clone(packed, c))
+	return true
+}
+
+// updateAny updates the message packed in the destination google.protobuf.Any with the masked message
+// packed in the source and returns a value indicating if the source's packed type has a mask. If the
+// destination packs another type, it's replaced. If only the destination's packed type has a mask,
+// its masked fields are cleared.
+func (mm *msgMask) updateAny(dst, src protoreflect.Message, c *callState) bool {
+	sub, packed, ok := mm.anyMask(src)
+	if !ok {
+		if sub, packed, ok := mm.anyMask(dst); ok && packed != nil {
+			sub.update(packed, packed.Type().Zero(), c)
+			mm.packAny(dst, dst.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)), packed)
+		}
+		return false
+	}
+	if packed == nil {
+		mm.copyAny(dst, src)
+		return true
+	}
+	var out protoreflect.Message
+	if mm.packedName(dst) == mm.packedName(src) {
+		out = mm.unpackAny(dst)
+	}
+	if out == nil {
+		out = packed.New()
+	}
+	sub.update(out, packed, c)
+	mm.packAny(dst, src.Get(mm.fldDescs.ByNumber(anyTypeURLNumber)), out)
+	return true
+}
+
+// coversAny returns a value indicating if the subpath of the message packed in the google.protobuf.Any
+// is covered, and a value indicating if the path begins with a type name.
+func (mm *msgMask) coversAny(msg protoreflect.Message, path string) (covered, ok bool) {
+	if !mm.isAny() {
+		return false, false
+	}
+	segment, subpath, err := nextSegment(path, mm.settings.pathSep)
+	if err != nil {
+		return false, false
+	}
+	name, ok := anyTypeName(segment)
+	if !ok {
+		return false, false
+	}
+	if mm.packedName(msg) != name {
+		return false, true
+	}
+	packed := mm.unpackAny(msg)
+	if packed == nil {
+		return false, true
+	}
+	if mm.complete() {
+		return newMsgMask(mm.settings, packed.Descriptor()).covers(packed, subpath), true
+	}
+	sub, ok := mm.anyMasks[name]
+	return ok && sub.covers(packed, subpath), true
+}
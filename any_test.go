@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newAny(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(m)
+	if err != nil {
+		t.Fatalf("Failed to create any: %v", err)
+	}
+	return a
+}
+
+func TestAny(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field: 1,
+		AnyField:   newAny(t, &testpb.Message{Int32Field: 2, StringField: "a"}),
+		RepeatedAnyField: []*anypb.Any{
+			newAny(t, &testpb.Message{Int32Field: 3, StringField: "b"}),
+			newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(4), StringField: proto.String("c")}),
+			{TypeUrl: "type.googleapis.com/unknown.Type", Value: []byte{0x08, 0x05}},
+		},
+		MapStringAnyField: map[string]*anypb.Any{
+			"x": newAny(t, &testpb.Message{Int32Field: 6, StringField: "d"}),
+			"y": newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(7), StringField: proto.String("e")}),
+		},
+	}
+	for _, tt := range []basicTest{
+		{
+			name:  "singular",
+			mask:  "any_field.(dev.bursavich.fieldmask.test.Message).int32_field",
+			paths: []string{"any_field.(dev.bursavich.fieldmask.test.Message).int32_field"},
+			msg:   msg,
+			out: &testpb.Message{
+				AnyField: newAny(t, &testpb.Message{Int32Field: 2}),
+			},
+		},
+		{
+			name: "list",
+			mask: "repeated_any_field.*.(dev.bursavich.fieldmask.test.Message).string_field",
+			paths: []string{
+				"repeated_any_field.*.(dev.bursavich.fieldmask.test.Message).string_field",
+			},
+			msg: msg,
+			out: &testpb.Message{
+				RepeatedAnyField: []*anypb.Any{
+					newAny(t, &testpb.Message{StringField: "b"}),
+					{},
+					{TypeUrl: "type.googleapis.com/unknown.Type", Value: []byte{0x08, 0x05}},
+				},
+			},
+		},
+		{
+			name: "list types",
+			mask: "repeated_any_field.*.(dev.bursavich.fieldmask.test.Proto2Message).int32_field," +
+				"repeated_any_field.*.(dev.bursavich.fieldmask.test.Message)",
+			paths: []string{
+				"repeated_any_field.*.(dev.bursavich.fieldmask.test.Message)",
+				"repeated_any_field.*.(dev.bursavich.fieldmask.test.Proto2Message).int32_field",
+			},
+			msg: msg,
+			out: &testpb.Message{
+				RepeatedAnyField: []*anypb.Any{
+					newAny(t, &testpb.Message{Int32Field: 3, StringField: "b"}),
+					newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(4)}),
+					{TypeUrl: "type.googleapis.com/unknown.Type", Value: []byte{0x08, 0x05}},
+				},
+			},
+		},
+		{
+			name: "map",
+			mask: "map_string_any_field.*.(dev.bursavich.fieldmask.test.Message).int32_field," +
+				"map_string_any_field.y.(dev.bursavich.fieldmask.test.Proto2Message).string_field",
+			paths: []string{
+				"map_string_any_field.*.(dev.bursavich.fieldmask.test.Message).int32_field",
+				"map_string_any_field.y.(dev.bursavich.fieldmask.test.Proto2Message).string_field",
+			},
+			msg: msg,
+			out: &testpb.Message{
+				MapStringAnyField: map[string]*anypb.Any{
+					"x": newAny(t, &testpb.Message{Int32Field: 6}),
+					"y": newAny(t, &testpb.Proto2Message{StringField: proto.String("e")}),
+				},
+			},
+		},
+		{
+			name: "unknown type",
+			mask: "repeated_any_field.*.(unknown.Type).int32_field",
+			err:  true,
+		},
+		{
+			name: "not any",
+			mask: "message_field.(dev.bursavich.fieldmask.test.Message).int32_field",
+			err:  true,
+		},
+	} {
+		tt.run(t)
+	}
+}
+
+func TestUpdateAny(t *testing.T) {
+	for _, tt := range []updateTest{
+		{
+			name: "list",
+			mask: "repeated_any_field.*.(dev.bursavich.fieldmask.test.Message).int32_field",
+			dst: &testpb.Message{
+				RepeatedAnyField: []*anypb.Any{
+					newAny(t, &testpb.Message{Int32Field: 1, StringField: "a"}),
+				},
+			},
+			src: &testpb.Message{
+				RepeatedAnyField: []*anypb.Any{
+					newAny(t, &testpb.Message{Int32Field: 2, StringField: "b"}),
+					{TypeUrl: "type.googleapis.com/unknown.Type", Value: []byte{0x08, 0x05}},
+				},
+			},
+			out: &testpb.Message{
+				RepeatedAnyField: []*anypb.Any{
+					newAny(t, &testpb.Message{Int32Field: 2}),
+					{TypeUrl: "type.googleapis.com/unknown.Type", Value: []byte{0x08, 0x05}},
+				},
+			},
+		},
+		{
+			name: "map",
+			mask: "map_string_any_field.x.(dev.bursavich.fieldmask.test.Message).int32_field",
+			dst: &testpb.Message{
+				MapStringAnyField: map[string]*anypb.Any{
+					"x": newAny(t, &testpb.Message{Int32Field: 1, StringField: "a"}),
+					"y": newAny(t, &testpb.Message{Int32Field: 3}),
+				},
+			},
+			src: &testpb.Message{
+				MapStringAnyField: map[string]*anypb.Any{
+					"x": newAny(t, &testpb.Message{Int32Field: 2, StringField: "b"}),
+					"y": newAny(t, &testpb.Message{Int32Field: 4}),
+				},
+			},
+			out: &testpb.Message{
+				MapStringAnyField: map[string]*anypb.Any{
+					"x": newAny(t, &testpb.Message{Int32Field: 2, StringField: "a"}),
+					"y": newAny(t, &testpb.Message{Int32Field: 3}),
+				},
+			},
+		},
+		{
+			name: "replaced type",
+			mask: "any_field.(dev.bursavich.fieldmask.test.Proto2Message).int32_field",
+			dst: &testpb.Message{
+				AnyField: newAny(t, &testpb.Message{Int32Field: 1}),
+			},
+			src: &testpb.Message{
+				AnyField: newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(2), StringField: proto.String("a")}),
+			},
+			out: &testpb.Message{
+				AnyField: newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(2)}),
+			},
+		},
+		{
+			name: "cleared",
+			mask: "any_field.(dev.bursavich.fieldmask.test.Message).int32_field",
+			dst: &testpb.Message{
+				AnyField: newAny(t, &testpb.Message{Int32Field: 1, StringField: "a"}),
+			},
+			src: &testpb.Message{
+				AnyField: newAny(t, &testpb.Proto2Message{Int32Field: proto.Int32(2)}),
+			},
+			out: &testpb.Message{
+				AnyField: newAny(t, &testpb.Message{StringField: "a"}),
+			},
+		},
+	} {
+		tt.run(t)
+	}
+}
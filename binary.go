@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The binary encoding of a mask is:
+//
+//	version  byte
+//	root     string (the length-prefixed full name of the root message)
+//	count    varint (the number of paths)
+//	paths    [count]path
+//
+// where each path is a varint number of segments followed by the segments. A segment that
+// names a field is encoded as the varint field number shifted left by one. Any other segment,
+// such as a map key, a list index, or an extension name, is encoded as a string with the varint
+// length shifted left by one with the low bit set, followed by the bytes of the string.
+const binaryVersion = 1
+
+var errBinarySyntax = errors.New("fieldmask: invalid binary encoding")
+
+// MarshalBinary encodes the mask in a compact binary form.
+// It implements encoding.BinaryMarshaler.
+func (fm *FieldMask[T]) MarshalBinary() ([]byte, error) {
+	paths := fm.msg.paths()
+	b := []byte{binaryVersion}
+	b = protowire.AppendString(b, string(fm.rootDesc.FullName()))
+	b = protowire.AppendVarint(b, uint64(len(paths)))
+	for _, path := range paths {
+		b = fm.appendBinaryPath(b, path)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary replaces the paths of the mask with the paths decoded from the binary form.
+// The mask must have been created by a constructor, such as New, for the same message type
+// that encoded it. It implements encoding.BinaryUnmarshaler.
+func (fm *FieldMask[T]) UnmarshalBinary(data []byte) error {
+	if fm.msg == nil {
+		return errors.New("fieldmask: UnmarshalBinary on uninitialized mask")
+	}
+	if len(data) == 0 || data[0] != binaryVersion {
+		return errBinarySyntax
+	}
+	data = data[1:]
+	name, n := protowire.ConsumeString(data)
+	if n < 0 {
+		return errBinarySyntax
+	}
+	data = data[n:]
+	if want := fm.rootDesc.FullName(); protoreflect.FullName(name) != want {
+		return fmt.Errorf("mismatched message type: got %v; want %v", name, want)
+	}
+	count, n := protowire.ConsumeVarint(data)
+	if n < 0 || count > uint64(len(data)) {
+		return errBinarySyntax
+	}
+	data = data[n:]
+	paths := make([]string, 0, count)
+	for i := uint64(0); i < count; i++ {
+		path, n, err := fm.consumeBinaryPath(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		paths = append(paths, path)
+	}
+	if len(data) > 0 {
+		return errBinarySyntax
+	}
+	prev := fm.msg
+	fm.msg = newMsgMask(&fm.settings, fm.rootDesc)
+	if err := fm.appendPaths(paths); err != nil {
+		fm.msg = prev
+		return err
+	}
+	return nil
+}
+
+// binarySegment is a segment of a path in the binary form. It's either a field number or a string.
+type binarySegment struct {
+	num protoreflect.FieldNumber
+	str string
+}
+
+// appendBinaryPath appends the binary form of the path to b.
+// Field names are encoded as numbers where possible. Anything that can't be
+// resolved against the descriptors is encoded verbatim as the final segment.
+func (fm *FieldMask[T]) appendBinaryPath(b []byte, path string) []byte {
+	var segs []binarySegment
+	desc, keyed := fm.rootDesc, false
+	for path != "" {
+		segment, subpath, err := nextSegment(path, fm.pathSep)
+		if err != nil {
+			break
+		}
+		if keyed {
+			segs = append(segs, binarySegment{str: segment})
+			path, keyed = subpath, false
+			continue
+		}
+		if desc == nil {
+			break
+		}
+		_, fd, ok := fm.lookupField(desc.Fields(), segment)
+		if !ok || fd.IsExtension() {
+			break
+		}
+		segs = append(segs, binarySegment{num: fd.Number()})
+		desc, keyed = binaryElemDesc(fd)
+		path = subpath
+	}
+	if path != "" {
+		segs = append(segs, binarySegment{str: path})
+	}
+	b = protowire.AppendVarint(b, uint64(len(segs)))
+	for _, seg := range segs {
+		if seg.num != 0 {
+			b = protowire.AppendVarint(b, uint64(seg.num)<<1)
+			continue
+		}
+		b = protowire.AppendVarint(b, uint64(len(seg.str))<<1|1)
+		b = append(b, seg.str...)
+	}
+	return b
+}
+
+// consumeBinaryPath parses a path in the binary form from b.
+// It returns the path and the number of bytes consumed.
+func (fm *FieldMask[T]) consumeBinaryPath(b []byte) (string, int, error) {
+	count, n := protowire.ConsumeVarint(b)
+	if n < 0 || count == 0 || count > uint64(len(b)) {
+		return "", 0, errBinarySyntax
+	}
+	total := n
+	var path string
+	desc, keyed := fm.rootDesc, false
+	for i := uint64(0); i < count; i++ {
+		tag, n := protowire.ConsumeVarint(b[total:])
+		if n < 0 {
+			return "", 0, errBinarySyntax
+		}
+		total += n
+		var segment string
+		if tag&1 == 1 {
+			size := tag >> 1
+			if size > uint64(len(b)-total) {
+				return "", 0, errBinarySyntax
+			}
+			segment = string(b[total : total+int(size)])
+			total += int(size)
+			keyed = false
+		} else {
+			if desc == nil || keyed {
+				return "", 0, errBinarySyntax
+			}
+			num := protoreflect.FieldNumber(tag >> 1)
+			if desc.ReservedRanges().Has(num) {
+				return "", 0, fmt.Errorf("fieldmask: %v field number %d is reserved", desc.FullName(), num)
+			}
+			fd := desc.Fields().ByNumber(num)
+			if fd == nil {
+				return "", 0, fmt.Errorf("fieldmask: unknown %v field number: %d", desc.FullName(), tag>>1)
+			}
+			segment = fm.fieldKey(fd)
+			desc, keyed = binaryElemDesc(fd)
+		}
+		if path == "" {
+			path = segment
+		} else {
+			path = joinPath(path, segment, fm.pathSep)
+		}
+	}
+	return path, total, nil
+}
+
+// binaryElemDesc returns the descriptor of the message reached through the field, if any,
+// and a value indicating if the next segment is a map key or list index.
+func binaryElemDesc(fd protoreflect.FieldDescriptor) (protoreflect.MessageDescriptor, bool) {
+	switch {
+	case fd.IsMap():
+		return fd.MapValue().Message(), true
+	case fd.IsList():
+		return fd.Message(), true
+	default:
+		return fd.Message(), false
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strings"
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		mask string
+		opts []Option
+	}{
+		{name: "complete", mask: "*"},
+		{name: "scalars", mask: "int32_field,string_field"},
+		{name: "nested", mask: "message_field.message_field.int32_field,message_field.string_field"},
+		{name: "lists", mask: "repeated_string_field,repeated_message_field.*.int32_field,repeated_message_field.2.string_field"},
+		{name: "maps", mask: "map_string_string_field.foo,map_int32_message_field.*.string_field,map_string_message_field.`a.b`.message_field.int32_field"},
+		{name: "emptied", mask: "map_string_message_field.foo.,map_int32_message_field.*."},
+		{name: "json-names", mask: "int32Field,messageField.stringField", opts: []Option{WithFieldName(JSONFieldName, true)}},
+		{name: "separator", mask: "message_field/int32_field,map_string_string_field/`a/b`", opts: []Option{WithPathSeparator('/')}},
+		{name: "alias", mask: "id,message_field.string_field", opts: []Option{WithAlias(map[string]string{"id": "int32_field"})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			b, err := fm.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary: unexpected error: %v", err)
+			}
+			// The paths, excluding the version and the root name, are no larger than their text.
+			header := 2 + len(fm.Descriptor().FullName())
+			if n := len(fm.String()); len(b)-header > n {
+				t.Errorf("MarshalBinary: got %d bytes of paths; want at most %d", len(b)-header, n)
+			}
+			out, err := New[*testpb.Message](nil, tt.opts...)
+			if err != nil {
+				t.Fatalf("New: unexpected error: %v", err)
+			}
+			if err := out.UnmarshalBinary(b); err != nil {
+				t.Fatalf("UnmarshalBinary: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(fm.Paths(), out.Paths()); diff != "" {
+				t.Errorf("Paths: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	fm, err := Parse[*testpb.Message]("int32_field,message_field.string_field")
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	b, err := fm.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: unexpected error: %v", err)
+	}
+	for i := range b {
+		out, _ := Parse[*testpb.Message]("bool_field")
+		if err := out.UnmarshalBinary(b[:i]); err == nil {
+			t.Errorf("UnmarshalBinary(%d bytes): expected error", i)
+		}
+		if got, want := out.String(), "bool_field"; got != want {
+			t.Errorf("String after error: got: %q; want: %q", got, want)
+		}
+	}
+	if err := fm.UnmarshalBinary(append(b, 0)); err == nil {
+		t.Error("UnmarshalBinary(trailing bytes): expected error")
+	}
+
+	other, err := Parse[*testpb.Proto2Message]("*")
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if err := other.UnmarshalBinary(b); err == nil {
+		t.Error("UnmarshalBinary(mismatched type): expected error")
+	}
+
+	var zero FieldMask[*testpb.Message]
+	if err := zero.UnmarshalBinary(b); err == nil {
+		t.Error("UnmarshalBinary(uninitialized): expected error")
+	}
+}
+
+func TestBinaryReservedNumber(t *testing.T) {
+	fm, err := New[*testpb.Proto2Message](nil)
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	b := []byte{binaryVersion}
+	b = protowire.AppendString(b, string(fm.Descriptor().FullName()))
+	b = protowire.AppendVarint(b, 1)             // paths
+	b = protowire.AppendVarint(b, 1)             // segments
+	b = protowire.AppendVarint(b, uint64(21)<<1) // reserved field number
+	err = fm.UnmarshalBinary(b)
+	if err == nil {
+		t.Fatal("UnmarshalBinary: expected error")
+	}
+	if want := "field number 21 is reserved"; !strings.Contains(err.Error(), want) {
+		t.Errorf("UnmarshalBinary: got error %q; want it to contain %q", err, want)
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaskWithinBudget masks the message in place, like Mask, and then, if the encoded size of the
+// masked message exceeds maxBytes, drops populated leaves from it until it fits. A leaf is a
+// scalar or list field, a map entry, or a message field or map value with no populated fields.
+// The largest leaves are dropped first, with ties broken by path, along with the messages that they
+// empty. It returns the paths of the dropped leaves, and an error if the message still doesn't fit
+// after every leaf is dropped.
+func (fm *FieldMask[T]) MaskWithinBudget(msg T, maxBytes int) (dropped []string, err error) {
+	if maxBytes < 0 {
+		return nil, fmt.Errorf("invalid budget: %d", maxBytes)
+	}
+	fm.Mask(msg)
+	size := proto.Size(msg)
+	if size <= maxBytes {
+		return nil, nil
+	}
+	leaves := fm.budgetLeaves(nil, msg.ProtoReflect(), "", func() {})
+	slices.SortFunc(leaves, func(a, b budgetLeaf) int {
+		if c := cmp.Compare(b.size, a.size); c != 0 {
+			return c
+		}
+		return strings.Compare(a.path, b.path)
+	})
+	for _, leaf := range leaves {
+		leaf.clear()
+		dropped = append(dropped, leaf.path)
+		if size = proto.Size(msg); size <= maxBytes {
+			return dropped, nil
+		}
+	}
+	return dropped, fmt.Errorf("masked message size %d exceeds budget %d", size, maxBytes)
+}
+
+// A budgetLeaf is a populated leaf of a message that may be dropped to fit a budget.
+type budgetLeaf struct {
+	path  string
+	size  int // the encoded size of the leaf on its own
+	clear func()
+}
+
+// budgetLeaves appends the populated leaves of the message to leaves.
+// Their paths are relative to the given path of the message. The prune function
+// is called after a leaf is cleared to clear the message from its parent if it's empty.
+func (s *settings) budgetLeaves(leaves []budgetLeaf, msg protoreflect.Message, path string, prune func()) []budgetLeaf {
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		name := s.fieldKey(fd)
+		if path != "" {
+			name = joinPath(path, name, s.pathSep)
+		}
+		switch {
+		case fd.IsMap():
+			m := val.Map()
+			isMsg := isMessage(fd.MapValue().Kind())
+			m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				keyPath := joinPath(name, s.quoteKey(s.formatMapKey(fd, key)), s.pathSep)
+				if isMsg && hasPopulatedField(val.Message()) {
+					leaves = s.budgetLeaves(leaves, val.Message(), keyPath, func() {
+						if !hasPopulatedField(val.Message()) {
+							m.Clear(key)
+							prune()
+						}
+					})
+					return true
+				}
+				entry := msg.New()
+				entry.Mutable(fd).Map().Set(key, val)
+				leaves = append(leaves, budgetLeaf{
+					path: keyPath,
+					size: proto.Size(entry.Interface()),
+					clear: func() {
+						m.Clear(key)
+						prune()
+					},
+				})
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && hasPopulatedField(val.Message()):
+			leaves = s.budgetLeaves(leaves, val.Message(), name, func() {
+				if !hasPopulatedField(val.Message()) {
+					msg.Clear(fd)
+					prune()
+				}
+			})
+		default:
+			field := msg.New()
+			field.Set(fd, val)
+			leaves = append(leaves, budgetLeaf{
+				path: name,
+				size: proto.Size(field.Interface()),
+				clear: func() {
+					msg.Clear(fd)
+					prune()
+				},
+			})
+		}
+		return true
+	})
+	return leaves
+}
+
+// hasPopulatedField returns a value indicating if any field is populated in the message.
+func hasPopulatedField(msg protoreflect.Message) bool {
+	populated := false
+	msg.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		populated = true
+		return false
+	})
+	return populated
+}
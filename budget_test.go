@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strings"
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMaskWithinBudget(t *testing.T) {
+	newMsg := func() *testpb.Message {
+		return &testpb.Message{
+			Int32Field:  1,
+			StringField: strings.Repeat("s", 100),
+			Int64Field:  2,
+			MessageField: &testpb.Message{
+				StringField: strings.Repeat("m", 20),
+			},
+			MapStringStringField: map[string]string{
+				"a": strings.Repeat("a", 10),
+				"b": strings.Repeat("b", 50),
+				"c": strings.Repeat("c", 10),
+			},
+		}
+	}
+	fm, err := Parse[*testpb.Message]("int32_field,string_field,message_field,map_string_string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	masked := newMsg()
+	fm.Mask(masked)
+	size := proto.Size(masked)
+
+	for _, tt := range []struct {
+		name    string
+		budget  int
+		dropped []string
+		err     bool
+	}{
+		{
+			name:   "fits",
+			budget: size,
+		},
+		{
+			name:    "largest",
+			budget:  size - 1,
+			dropped: []string{"string_field"},
+		},
+		{
+			name:    "next-largest",
+			budget:  size - 110,
+			dropped: []string{"string_field", "map_string_string_field.b"},
+		},
+		{
+			name:   "exhausted",
+			budget: 0,
+			dropped: []string{
+				"string_field",
+				"map_string_string_field.b",
+				"message_field.string_field",
+				"map_string_string_field.a",
+				"map_string_string_field.c",
+				"int32_field",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMsg()
+			dropped, err := fm.MaskWithinBudget(msg, tt.budget)
+			if got, want := err != nil, tt.err; got != want {
+				t.Fatalf("MaskWithinBudget: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.dropped, dropped); diff != "" {
+				t.Fatalf("MaskWithinBudget: unexpected dropped paths:\n%s", diff)
+			}
+			if !tt.err && proto.Size(msg) > tt.budget {
+				t.Errorf("MaskWithinBudget: size %d exceeds budget %d", proto.Size(msg), tt.budget)
+			}
+			if msg.Int64Field != 0 {
+				t.Errorf("MaskWithinBudget: unmasked field retained")
+			}
+		})
+	}
+
+	// Messages emptied by dropping their leaves are dropped too.
+	fm, err = Parse[*testpb.Message]("message_field.message_field.string_field,map_string_message_field.*.string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	msg := &testpb.Message{
+		MessageField: &testpb.Message{
+			MessageField: &testpb.Message{StringField: "s"},
+		},
+		MapStringMessageField: map[string]*testpb.Message{
+			"a": {StringField: "a"},
+		},
+	}
+	dropped, err := fm.MaskWithinBudget(msg, 2)
+	if err != nil {
+		t.Fatalf("MaskWithinBudget: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"map_string_message_field.a.string_field", "message_field.message_field.string_field"}, dropped); diff != "" {
+		t.Errorf("MaskWithinBudget: unexpected dropped paths:\n%s", diff)
+	}
+	if diff := protoDiff(&testpb.Message{}, msg); diff != "" {
+		t.Errorf("MaskWithinBudget: unexpected diff:\n%s", diff)
+	}
+
+	if _, err := fm.MaskWithinBudget(newMsg(), -1); err == nil {
+		t.Error("MaskWithinBudget: expected error for negative budget")
+	}
+}
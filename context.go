@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"context"
+)
+
+// cancelCheckInterval is the number of fields visited between checks of the context.
+const cancelCheckInterval = 256
+
+// A callState holds the state of a single call that masks, clones, or updates a message.
+// It aborts the call when its context, if any, is done. It's checked for every field
+// visited, but only consults the context periodically to keep the cost low. It also
+// holds any update settings that override the mask's settings for the call.
+// A nil callState never aborts and has no overrides.
+type callState struct {
+	ctx       context.Context
+	n         int
+	overrides *updateSettings
+	path      string // path of the message being updated, if updated fields are reported
+	untracked int    // depth of nested values whose updated fields aren't reported
+	updating  bool   // values cloned for an update aren't transformed
+}
+
+// updates returns the effective update settings for the call.
+func (c *callState) updates(s *settings) *updateSettings {
+	if c == nil || c.overrides == nil {
+		return &s.updateSettings
+	}
+	return c.overrides
+}
+
+// updateState returns the state of an update call, creating it if it's needed.
+func (s *settings) updateState(c *callState) *callState {
+	if c == nil && (s.onUpdateField != nil || s.cloneTransform != nil) {
+		c = &callState{} // Tracks the path of updated fields and suppresses clone transforms.
+	}
+	if c != nil {
+		c.updating = true
+	}
+	return c
+}
+
+// transforms returns a value indicating if scalar values cloned during the call are transformed.
+func (c *callState) transforms(s *settings) bool {
+	return s.cloneTransform != nil && (c == nil || !c.updating)
+}
+
+// pushPath descends into the message field with the given key, if updated fields are reported,
+// and returns the path to restore with popPath.
+func (c *callState) pushPath(s *settings, key string) string {
+	if c == nil || s.onUpdateField == nil {
+		return ""
+	}
+	prev := c.path
+	c.path = c.fieldPath(s, key)
+	return prev
+}
+
+// popPath restores the path returned by pushPath.
+func (c *callState) popPath(prev string) {
+	if c != nil {
+		c.path = prev
+	}
+}
+
+// fieldPath returns the path of the field with the given key in the message being updated.
+func (c *callState) fieldPath(s *settings, key string) string {
+	if c.path == "" {
+		return key
+	}
+	return joinPath(c.path, key, s.pathSep)
+}
+
+// untrack stops reporting updated fields until the matching call to track,
+// while the fields of nested values of a reported field are updated.
+func (c *callState) untrack() {
+	if c != nil {
+		c.untracked++
+	}
+}
+
+// track resumes reporting updated fields after a call to untrack.
+func (c *callState) track() {
+	if c != nil {
+		c.untracked--
+	}
+}
+
+// canceled is the panic value used to unwind an aborted operation.
+type canceled struct{ err error }
+
+// check aborts the operation if the context is done.
+func (c *callState) check() {
+	if c == nil || c.ctx == nil {
+		return
+	}
+	if c.n++; c.n%cancelCheckInterval != 0 {
+		return
+	}
+	if err := c.ctx.Err(); err != nil {
+		panic(canceled{err})
+	}
+}
+
+// recover sets the error of an aborted operation.
+// It must be deferred by the function that started the operation.
+func (c *callState) recover(err *error) {
+	if r := recover(); r != nil {
+		x, ok := r.(canceled)
+		if !ok {
+			panic(r)
+		}
+		*err = x.err
+	}
+}
+
+// MaskContext masks the message in place, like Mask, but aborts with the context's error if the
+// context is done before it finishes. If it's aborted, the message may be partially masked.
+func (fm *FieldMask[T]) MaskContext(ctx context.Context, msg T) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c := &callState{ctx: ctx}
+	defer c.recover(&err)
+	fm.msg.mask(msg.ProtoReflect(), "", c)
+	fm.dropDefaultMessages(msg.ProtoReflect())
+	return nil
+}
+
+// CloneContext returns a masked clone of the message, like Clone, but aborts with the context's
+// error if the context is done before it finishes.
+func (fm *FieldMask[T]) CloneContext(ctx context.Context, msg T) (out T, err error) {
+	if err := ctx.Err(); err != nil {
+		return out, err
+	}
+	c := &callState{ctx: ctx}
+	defer c.recover(&err)
+	out = fm.msg.clone(msg.ProtoReflect(), c).Interface().(T)
+	fm.dropDefaultMessages(out.ProtoReflect())
+	return out, nil
+}
+
+// UpdateContext updates the destination message with the masked fields of the source message,
+// like Update, but aborts with the context's error if the context is done before it finishes.
+// If it's aborted, the destination message may be partially updated.
+func (fm *FieldMask[T]) UpdateContext(ctx context.Context, dst, src T) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c := &callState{ctx: ctx}
+	defer c.recover(&err)
+	return fm.update(dst, src, c)
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+)
+
+// cancelAfterCtx is a context that's canceled after its error has been checked n times.
+type cancelAfterCtx struct {
+	context.Context
+	n int
+}
+
+func (ctx *cancelAfterCtx) Err() error {
+	if ctx.n--; ctx.n < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func largeMsg(n int) *testpb.Message {
+	msg := &testpb.Message{
+		MapStringMessageField: make(map[string]*testpb.Message, n),
+	}
+	for i := 0; i < n; i++ {
+		msg.RepeatedMessageField = append(msg.RepeatedMessageField, simpleMsg(int32(i), strconv.Itoa(i)))
+		msg.MapStringMessageField[strconv.Itoa(i)] = simpleMsg(int32(i), strconv.Itoa(i))
+	}
+	return msg
+}
+
+func TestContext(t *testing.T) {
+	msg := largeMsg(1000)
+	for _, tt := range []struct {
+		mask string
+		noop bool // masking doesn't visit any fields
+	}{
+		{mask: "*", noop: true},
+		{mask: "repeated_message_field,map_string_message_field", noop: true},
+		{mask: "repeated_message_field.*.message_field.string_field,map_string_message_field.*.int32_field"},
+	} {
+		mask := tt.mask
+		fm, err := Parse[*testpb.Message](mask)
+		if err != nil {
+			t.Fatalf("Parse(%q): unexpected error: %v", mask, err)
+		}
+		t.Run(mask, func(t *testing.T) {
+			ctx := context.Background()
+
+			got := clone(msg)
+			if err := fm.MaskContext(ctx, got); err != nil {
+				t.Fatalf("MaskContext: unexpected error: %v", err)
+			}
+			want := clone(msg)
+			fm.Mask(want)
+			if diff := protoDiff(want, got); diff != "" {
+				t.Errorf("MaskContext: unexpected diff:\n%s", diff)
+			}
+
+			got, err := fm.CloneContext(ctx, msg)
+			if err != nil {
+				t.Fatalf("CloneContext: unexpected error: %v", err)
+			}
+			if diff := protoDiff(fm.Clone(msg), got); diff != "" {
+				t.Errorf("CloneContext: unexpected diff:\n%s", diff)
+			}
+
+			got = &testpb.Message{Int32Field: 1}
+			if err := fm.UpdateContext(ctx, got, msg); err != nil {
+				t.Fatalf("UpdateContext: unexpected error: %v", err)
+			}
+			want = &testpb.Message{Int32Field: 1}
+			if err := fm.Update(want, msg); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if diff := protoDiff(want, got); diff != "" {
+				t.Errorf("UpdateContext: unexpected diff:\n%s", diff)
+			}
+			if err := fm.UpdateContext(ctx, nil, msg); err != errNilDestination {
+				t.Errorf("UpdateContext(nil): got: %v; want: %v", err, errNilDestination)
+			}
+
+			for _, n := range []int{0, 1} {
+				if err := fm.MaskContext(&cancelAfterCtx{ctx, n}, clone(msg)); !errors.Is(err, context.Canceled) && !(tt.noop && n > 0) {
+					t.Errorf("MaskContext(canceled after %d): got: %v; want: %v", n, err, context.Canceled)
+				}
+				if out, err := fm.CloneContext(&cancelAfterCtx{ctx, n}, msg); !errors.Is(err, context.Canceled) || out != nil {
+					t.Errorf("CloneContext(canceled after %d): got: %v, %v; want: nil, %v", n, out, err, context.Canceled)
+				}
+				if err := fm.UpdateContext(&cancelAfterCtx{ctx, n}, &testpb.Message{}, msg); !errors.Is(err, context.Canceled) {
+					t.Errorf("UpdateContext(canceled after %d): got: %v; want: %v", n, err, context.Canceled)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+// DebugTree returns a compact, indented rendering of the mask's internal tree.
+// Each line names a field, a map key, a list element selector, or the packed type
+// of a google.protobuf.Any. Map keys and list indexes are shown in brackets, with
+// "[*]" for wildcard masks, packed types are shown in parentheses, and nodes
+// that retain their entire value are marked "(complete)".
+//
+// The format is intended for debugging and is not stable.
+func (fm *FieldMask[T]) DebugTree() string {
+	var b strings.Builder
+	b.WriteString(string(fm.msg.desc.FullName()))
+	fm.msg.writeTree(&b, 1)
+	return b.String()
+}
+
+// treeWriter is implemented by masks that can render their subtree for DebugTree.
+type treeWriter interface {
+	// writeTree finishes the current line and writes the mask's children
+	// at the given depth.
+	writeTree(b *strings.Builder, depth int)
+}
+
+var (
+	_ treeWriter = (*msgMask)(nil)
+	_ treeWriter = (*msgFieldMask)(nil)
+	_ treeWriter = (*scalarFieldMask)(nil)
+	_ treeWriter = (*scalarListFieldMask)(nil)
+	_ treeWriter = (*msgListFieldMask)(nil)
+	_ treeWriter = (*scalarMapFieldMask[string])(nil)
+	_ treeWriter = (*msgMapFieldMask[string])(nil)
+)
+
+func writeTreeLine(b *strings.Builder, depth int, label string) {
+	b.WriteByte('\n')
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(label)
+}
+
+func writeTreeComplete(b *strings.Builder) {
+	b.WriteString(" (complete)")
+}
+
+func writeSubtree(b *strings.Builder, depth int, label string, mm *msgMask) {
+	writeTreeLine(b, depth, label)
+	if mm == nil {
+		writeTreeComplete(b)
+		return
+	}
+	mm.writeTree(b, depth+1)
+}
+
+func (fm *msgMask) writeTree(b *strings.Builder, depth int) {
+	if fm.complete() {
+		writeTreeComplete(b)
+		return
+	}
+	names := maps.Keys(fm.anyMasks)
+	slices.Sort(names)
+	for _, name := range names {
+		writeSubtree(b, depth, anySegment(name), fm.anyMasks[name])
+	}
+	keys := maps.Keys(fm.fields)
+	slices.Sort(keys)
+	for _, key := range keys {
+		writeTreeLine(b, depth, key)
+		fm.fields[key].(treeWriter).writeTree(b, depth+1)
+	}
+}
+
+func (fm *scalarFieldMask) writeTree(b *strings.Builder, depth int) {}
+
+func (fm *scalarListFieldMask) writeTree(b *strings.Builder, depth int) {
+	if fm.complete() {
+		writeTreeComplete(b)
+		return
+	}
+	writeTreeLine(b, depth, fm.slice.String())
+}
+
+func (fm *msgListFieldMask) writeTree(b *strings.Builder, depth int) {
+	if fm.complete() {
+		writeTreeComplete(b)
+		return
+	}
+	if fm.slice != nil {
+		writeSubtree(b, depth, fm.slice.String(), fm.wildMask)
+		return
+	}
+	if fm.wildMask != nil {
+		writeSubtree(b, depth, "[*]", fm.wildMask)
+	}
+	idxs := maps.Keys(fm.indexedMasks)
+	slices.Sort(idxs)
+	for _, idx := range idxs {
+		writeSubtree(b, depth, "["+strconv.Itoa(idx)+"]", fm.indexedMasks[idx])
+	}
+}
+
+func (fm *scalarMapFieldMask[T]) writeTree(b *strings.Builder, depth int) {
+	if fm.complete() {
+		writeTreeComplete(b)
+		return
+	}
+	for _, key := range fm.formatKeys(maps.Keys(fm.keys)) {
+		writeTreeLine(b, depth, "["+key+"]")
+	}
+}
+
+func (fm *msgMapFieldMask[T]) writeTree(b *strings.Builder, depth int) {
+	if fm.complete() {
+		writeTreeComplete(b)
+		return
+	}
+	if fm.wildMask != nil {
+		writeSubtree(b, depth, "[*]", fm.wildMask)
+	}
+	keys := maps.Keys(fm.keyedMasks)
+	slices.Sort(keys)
+	for _, key := range keys {
+		writeSubtree(b, depth, "["+fm.format(key)+"]", fm.keyedMasks[key])
+	}
+}
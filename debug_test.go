@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strings"
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDebugTree(t *testing.T) {
+	tests := []struct {
+		name string
+		mask string
+		want []string
+	}{
+		{
+			name: "complete",
+			mask: "*",
+			want: []string{
+				"dev.bursavich.fieldmask.test.Message (complete)",
+			},
+		},
+		{
+			name: "fields",
+			mask: "int32_field,message_field.string_field,message_field.message_field",
+			want: []string{
+				"dev.bursavich.fieldmask.test.Message",
+				"  int32_field",
+				"  message_field",
+				"    message_field (complete)",
+				"    string_field",
+			},
+		},
+		{
+			name: "lists",
+			mask: "repeated_int32_field[-3:],repeated_message_field.*.int32_field,repeated_message_field.1,repeated_string_field",
+			want: []string{
+				"dev.bursavich.fieldmask.test.Message",
+				"  repeated_int32_field",
+				"    [-3:]",
+				"  repeated_message_field",
+				"    [*]",
+				"      int32_field",
+				"    [1] (complete)",
+				"  repeated_string_field (complete)",
+			},
+		},
+		{
+			name: "maps",
+			mask: "map_int32_string_field.7,map_int32_string_field.-1,map_string_message_field.*.int32_field,map_string_message_field.b.string_field,map_string_string_field",
+			want: []string{
+				"dev.bursavich.fieldmask.test.Message",
+				"  map_int32_string_field",
+				"    [-1]",
+				"    [7]",
+				"  map_string_message_field",
+				"    [*]",
+				"      int32_field",
+				"    [b]",
+				"      int32_field",
+				"      string_field",
+				"  map_string_string_field (complete)",
+			},
+		},
+		{
+			name: "any",
+			mask: "any_field.(dev.bursavich.fieldmask.test.Message).int32_field",
+			want: []string{
+				"dev.bursavich.fieldmask.test.Message",
+				"  any_field",
+				"    (dev.bursavich.fieldmask.test.Message)",
+				"      int32_field",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := New[*testpb.Message](strings.Split(tt.mask, ","))
+			if err != nil {
+				t.Fatalf("New(%q): %v", tt.mask, err)
+			}
+			got := strings.Split(fm.DebugTree(), "\n")
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DebugTree(): (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
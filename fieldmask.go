@@ -11,23 +11,48 @@
 package fieldmask
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 
+	"bursavich.dev/fieldmask/maskpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // https://google.aip.dev/161 Field masks
 // https://google.aip.dev/203 Field behavior documentation (TODO: IMMUTABLE, OUTPUT_ONLY. INPUT_ONLY)
 // https://google.aip.dev/134 Standard methods: Update
 
+var errNilDestination = errors.New("fieldmask: nil destination")
+
 type Option interface{ applyOption(*settings) }
 
 type optionFunc func(*settings)
 
 func (fn optionFunc) applyOption(s *settings) { fn(s) }
 
+// An UpdateOption is an Option that may also be given to UpdateWith to override
+// the mask's setting for a single update.
+type UpdateOption interface {
+	Option
+	applyUpdateOption(*updateSettings)
+}
+
+type updateOptionFunc func(*updateSettings)
+
+func (fn updateOptionFunc) applyOption(s *settings) { fn(&s.updateSettings) }
+
+func (fn updateOptionFunc) applyUpdateOption(u *updateSettings) { fn(u) }
+
 // WithMessageDescriptor returns an option that sets the message descriptor.
 // This is useful for dynamic types constructed at runtime.
 func WithMessageDescriptor(desc protoreflect.MessageDescriptor) Option {
@@ -39,6 +64,205 @@ func WithExtensions(allow bool) Option {
 	return optionFunc(func(s *settings) { s.extensions = allow })
 }
 
+// WithExtensionResolver returns an option that sets the resolver used to look up extensions
+// named by their full names in paths, such as "`example.foo_ext`" or "`example.foo_ext`.bar_field".
+// It only applies if extensions are allowed. By default, protoregistry.GlobalTypes is used.
+func WithExtensionResolver(resolver protoregistry.ExtensionTypeResolver) Option {
+	return optionFunc(func(s *settings) { s.extResolver = resolver })
+}
+
+// WithDefaultMaskExtension returns an option that sets the message option read by DefaultMask.
+// It must be a repeated string extension of google.protobuf.MessageOptions.
+// By default, the (dev.bursavich.fieldmask.default_mask) option is used.
+func WithDefaultMaskExtension(xt protoreflect.ExtensionType) Option {
+	return optionFunc(func(s *settings) { s.defaultMaskExt = xt })
+}
+
+// WithFieldFilter returns an option that sets a filter for fields.
+// Fields for which the filter returns false are never masked, cloned, or updated,
+// even if they're named by a path. Paths that name such a field within the values
+// of a map are rejected.
+func WithFieldFilter(filter func(protoreflect.FieldDescriptor) bool) Option {
+	return optionFunc(func(s *settings) { s.fieldFilter = filter })
+}
+
+// WithOnClear returns an option that sets a callback which is invoked by Mask whenever it clears
+// a field or map entry from a message. The path is the fully-qualified path of the cleared value,
+// where map entries are identified by their keys and list elements are identified by their indices.
+func WithOnClear(fn func(path string, fd protoreflect.FieldDescriptor)) Option {
+	return optionFunc(func(s *settings) { s.onClear = fn })
+}
+
+// WithOnUpdateField returns an option that sets a callback that's invoked by Update for each field it
+// sets or clears in the destination message, whether or not the field's value changes. The path is the
+// fully-qualified path of the field, and cleared indicates if it was cleared rather than set. A message
+// field that's set isn't reported itself; its updated fields are reported instead, which are all of its
+// fields if it's covered in full. A list or map field is reported as a whole. Fields left untouched, such
+// as those rejected by an update condition or absent lists in append mode, aren't reported.
+func WithOnUpdateField(fn func(path string, fd protoreflect.FieldDescriptor, cleared bool)) Option {
+	return optionFunc(func(s *settings) { s.onUpdateField = fn })
+}
+
+// WithRejectDeprecated returns an option that sets whether a path
+// that names a field marked as deprecated is rejected with an error.
+func WithRejectDeprecated(reject bool) Option {
+	return optionFunc(func(s *settings) { s.rejectDeprecated = reject })
+}
+
+// WithSubsetOf returns an option that requires every path of the mask to be covered by the parent mask,
+// such as to keep a client's mask within the fields that a server grants, so that building the mask fails
+// with an error that names the first path that isn't covered. A mask that covers the whole message is only
+// a subset of a parent mask that does too. Paths added by WithAlwaysInclude aren't checked. The parent mask
+// must be for the same message type and must name fields, separate paths, and format map keys in the same way.
+func WithSubsetOf[T proto.Message](parent *FieldMask[T]) Option {
+	return optionFunc(func(s *settings) {
+		s.subsetOf = &subsetMask{settings: &parent.settings, covers: parent.coversPath}
+	})
+}
+
+// WithRejectOverriddenPaths returns an option that sets whether a path that selects a list or map field
+// as a whole, or with its wildcard, is rejected with an error if another path of the mask selects subfields,
+// elements, or keys of the same field, or vice versa. For example, "repeated_field.*.a" is silently
+// overridden by "repeated_field" unless overridden paths are rejected. The error reports both paths.
+// Paths that are added from messages, such as by PopulatedMask or DiffAll, aren't checked.
+func WithRejectOverriddenPaths(reject bool) Option {
+	return optionFunc(func(s *settings) { s.rejectOverrides = reject })
+}
+
+// WithDisallowScalarListSubpaths returns an option that sets whether a path that descends into a repeated
+// scalar field, such as "repeated_field.*" or "repeated_field[-3:]", is rejected with an error, so that the
+// field may only be selected as a whole. By default, such paths are allowed, and a wildcard is equivalent
+// to selecting the whole field.
+func WithDisallowScalarListSubpaths(disallow bool) Option {
+	return optionFunc(func(s *settings) { s.rejectScalarListSubpaths = disallow })
+}
+
+// WithWarnDeprecated returns an option that sets a callback which is invoked
+// whenever a path names a field marked as deprecated.
+func WithWarnDeprecated(fn func(fd protoreflect.FieldDescriptor)) Option {
+	return optionFunc(func(s *settings) { s.warnDeprecated = fn })
+}
+
+// WithAlias returns an option that adds aliases for path prefixes. Each key of the map is an
+// external path prefix that's replaced by its value, an internal path prefix, when a path is added
+// to the mask. The replacement is reversed when the mask's paths are returned. For example, with the
+// alias "name" for "display_name", the path "name" selects the display_name field and the path
+// "name.first" selects the display_name.first field. An alias must not shadow an existing path.
+func WithAlias(aliases map[string]string) Option {
+	return optionFunc(func(s *settings) {
+		if s.aliases == nil {
+			s.aliases = make(map[string]string, len(aliases))
+		}
+		for external, internal := range aliases {
+			s.aliases[external] = internal
+		}
+	})
+}
+
+// WithAlwaysInclude returns an option that sets paths that are added to every mask with the settings,
+// regardless of the paths with which it's built, so that Mask and Clone never clear them. A mask that
+// covers the whole message is left as is. The paths are validated when the mask is built.
+func WithAlwaysInclude(paths ...string) Option {
+	return optionFunc(func(s *settings) { s.alwaysInclude = append(s.alwaysInclude, paths...) })
+}
+
+// WithRejectRecursion returns an option that sets whether a path that descends into a message
+// of the same type as one of its ancestors is rejected with an error. Selecting a recursive field
+// as a whole is allowed. By default, recursion is allowed.
+func WithRejectRecursion(reject bool) Option {
+	return optionFunc(func(s *settings) { s.rejectRecursion = reject })
+}
+
+// WithFieldMaxDepth returns an option that limits the number of times the field at the given path
+// may occur along any single path of the mask, which bounds the depth of a recursive field, such as
+// an org chart's reports, without limiting the depth of any other fields. Parsing a mask that exceeds
+// the limit results in an error. The path may only traverse singular message fields, and the limit
+// applies to the field wherever it's reached by the mask.
+func WithFieldMaxDepth(fieldPath string, depth int) Option {
+	return optionFunc(func(s *settings) {
+		s.fieldMaxDepthPaths = append(s.fieldMaxDepthPaths, fieldMaxDepthPath{fieldPath, depth})
+	})
+}
+
+// WithMaxMapKeys returns an option that sets the maximum number of keys that may be
+// selected for any single map field. Parsing a mask that exceeds the limit results in an error.
+// If n is zero or negative, the number of keys is unlimited. This is the default behavior.
+func WithMaxMapKeys(n int) Option {
+	return optionFunc(func(s *settings) { s.maxMapKeys = n })
+}
+
+// WithKeyedOverridesWild returns an option that sets whether the paths of a specific key of a map of messages
+// override the paths of the map's wildcard key for that key. For example, with the paths "m.*.a" and "m.k.b",
+// the value with key k is masked to its b field if keyed paths override wildcard paths, or to its a and b fields
+// if they don't. A wildcard that covers the whole value, such as "m.*", covers the whole value of every key
+// either way. By default, keyed paths add to wildcard paths.
+func WithKeyedOverridesWild(override bool) Option {
+	return optionFunc(func(s *settings) { s.keyedOverridesWild = override })
+}
+
+// WithMapKeyFilter returns an option that sets a filter for the keys of the map field at the given path.
+// When a message is masked or cloned, the map retains only the entries whose keys pass the filter
+// and are also selected by the mask. The path may only traverse singular message fields, and the
+// filter applies to the map field wherever it's reached by the mask.
+func WithMapKeyFilter(fieldPath string, filter func(protoreflect.MapKey) bool) Option {
+	return optionFunc(func(s *settings) {
+		s.mapKeyFilterPaths = append(s.mapKeyFilterPaths, mapKeyFilterPath{fieldPath, filter})
+	})
+}
+
+// WithMapKeyTransform returns an option that sets functions that translate between the keys of the string-keyed
+// map field at the given path as they're named in paths, such as friendly slugs, and as they're stored in messages,
+// such as opaque IDs. When a path is parsed, toKey translates its key segment, after it's unquoted and decoded,
+// and an error is returned if it fails. When paths are returned, fromKey translates the key back before it's
+// encoded and quoted. The path may only traverse singular message fields, and the transform applies to the
+// map field wherever it's reached by the mask.
+func WithMapKeyTransform(fieldPath string, toKey func(string) (string, error), fromKey func(string) string) Option {
+	return optionFunc(func(s *settings) {
+		s.mapKeyTransformPaths = append(s.mapKeyTransformPaths, mapKeyTransformPath{fieldPath, mapKeyTransform{toKey, fromKey}})
+	})
+}
+
+// WithEnumValueFilter returns an option that sets the allowed values of the enum field at the given path.
+// When a message is masked or cloned, a singular enum field with a value outside the allowed set is
+// cleared, a repeated enum field retains only its allowed elements, and an enum-valued map field
+// retains only the entries with allowed values. The path may only traverse singular message fields,
+// and the filter applies to the enum field wherever it's reached by the mask.
+func WithEnumValueFilter(fieldPath string, allowed []protoreflect.EnumNumber) Option {
+	return optionFunc(func(s *settings) {
+		s.enumFilterPaths = append(s.enumFilterPaths, enumFilterPath{fieldPath, allowed})
+	})
+}
+
+// WithRepeatedDistinctBy returns an option that sets a singular scalar subfield by which the elements of the
+// repeated message field at the given path are made distinct when it's masked or cloned, keeping the first
+// element with each value. By default, duplicate elements are kept.
+func WithRepeatedDistinctBy(fieldPath, subfield string) Option {
+	return optionFunc(func(s *settings) {
+		s.distinctByPaths = append(s.distinctByPaths, distinctByPath{fieldPath, subfield})
+	})
+}
+
+// MapValueUpdate specifies how to update the message values of a map.
+type MapValueUpdate int
+
+const (
+	// MergeMapValues merges the masked fields of a source message value into the destination message value.
+	// This is the default behavior.
+	MergeMapValues MapValueUpdate = iota
+	// ReplaceMapValues replaces the destination message value with the masked source message value.
+	ReplaceMapValues
+)
+
+// WithMapValueUpdate returns an option that sets the given mode for updating the message values
+// with the given keys in the map field at the given path. The key "*" sets the mode for any
+// keys that are covered by a wildcard but aren't explicitly named by the mask. The path may only
+// traverse singular message fields.
+func WithMapValueUpdate(fieldPath string, mode MapValueUpdate, keys ...string) Option {
+	return optionFunc(func(s *settings) {
+		s.mapValueUpdatePaths = append(s.mapValueUpdatePaths, mapValueUpdatePath{fieldPath, mode, keys})
+	})
+}
+
 // FieldName specifies which field name to prefer when parsing and outputting paths.
 type FieldName int
 
@@ -55,6 +279,8 @@ const (
 // The specified mode is always used when outputing paths.
 func WithFieldName(mode FieldName, strict bool) Option {
 	return optionFunc(func(s *settings) {
+		s.fieldName = mode
+		s.strictNames = strict
 		switch mode {
 		case TextFieldName:
 			lookup := lookupTextField
@@ -72,6 +298,68 @@ func WithFieldName(mode FieldName, strict bool) Option {
 	})
 }
 
+// WithPathSeparator returns an option that sets the separator between the segments of a path.
+// It's used both when parsing and outputting paths. The default separator is '.'.
+// Segments containing the separator may be quoted with backticks. The separator must be printable
+// and must not be a character with another meaning in paths, such as ',', '*', '`', '#', or '['.
+func WithPathSeparator(sep rune) Option {
+	return optionFunc(func(s *settings) { s.pathSep = sep })
+}
+
+// WithSpaceSeparatedPaths returns an option that sets whether Parse accepts paths separated by
+// whitespace in addition to commas. A run of whitespace, including any whitespace around a comma,
+// separates two paths, and leading and trailing whitespace is ignored. Whitespace inside a quoted
+// segment is preserved. Paths are still output with commas, but map keys containing whitespace
+// are quoted so that the output may be parsed again.
+func WithSpaceSeparatedPaths(spaces bool) Option {
+	return optionFunc(func(s *settings) { s.spaceSeparated = spaces })
+}
+
+// WithGlobExpansion returns an option that sets whether a field name containing "*", other than
+// a standalone "*" wildcard, is treated as a glob that's expanded to the names of the matching
+// fields of the message, such as "int*_field" or "*_oneof_field". The glob syntax is that of
+// path.Match and the rest of the path applies to each matching field. Expansion happens when
+// a path is added, so the mask's paths name the matching fields rather than the glob.
+// It's an error if a glob doesn't match any fields.
+func WithGlobExpansion(expand bool) Option {
+	return optionFunc(func(s *settings) { s.globExpansion = expand })
+}
+
+// BoolKeyStyle specifies how to format the keys of bool-keyed maps when outputting paths.
+// Either style is accepted when parsing paths, as are any other values accepted by strconv.ParseBool.
+type BoolKeyStyle int
+
+const (
+	// TextBoolKeys formats bool keys as "true" and "false".
+	// This is the default behavior.
+	TextBoolKeys BoolKeyStyle = iota
+	// NumericBoolKeys formats bool keys as "1" and "0".
+	NumericBoolKeys
+)
+
+// WithBoolKeyStyle returns an option that sets the given style for formatting bool map keys.
+func WithBoolKeyStyle(style BoolKeyStyle) Option {
+	return optionFunc(func(s *settings) { s.boolKeyStyle = style })
+}
+
+// KeyEncoding specifies how to encode the keys of string-keyed maps in paths.
+type KeyEncoding int
+
+const (
+	// QuotedKeys quotes keys with backticks when they contain special characters.
+	// This is the default behavior.
+	QuotedKeys KeyEncoding = iota
+	// PercentEncoding percent-encodes keys, as in URLs. Keys are percent-decoded when
+	// parsing paths and every byte other than an unreserved character, including '.',
+	// is percent-encoded when outputting paths.
+	PercentEncoding
+)
+
+// WithKeyEncoding returns an option that sets the given encoding for the keys of string-keyed maps.
+func WithKeyEncoding(enc KeyEncoding) Option {
+	return optionFunc(func(s *settings) { s.keyEncoding = enc })
+}
+
 // MaskUnknowns specifies how to handle unknown fields when a message is masked.
 type MaskUnknowns int
 
@@ -88,6 +376,33 @@ func WithMaskUnknowns(mode MaskUnknowns) Option {
 	return optionFunc(func(s *settings) { s.maskUnknowns = mode })
 }
 
+// WithRetainUnknownNumbers returns an option that sets the numbers of unknown fields
+// to retain when a message is masked with MaskRemovesUnknowns. Any other unknown fields
+// are removed. It has no effect with MaskRetainsUnknowns.
+func WithRetainUnknownNumbers(nums ...protoreflect.FieldNumber) Option {
+	return optionFunc(func(s *settings) {
+		if s.retainUnknowns == nil {
+			s.retainUnknowns = make(map[protoreflect.FieldNumber]bool, len(nums))
+		}
+		for _, num := range nums {
+			s.retainUnknowns[num] = true
+		}
+	})
+}
+
+// WithDropUnknownsFor returns an option that sets the message types whose unknown fields
+// are always removed when a message is masked, regardless of the MaskUnknowns mode.
+func WithDropUnknownsFor(names ...protoreflect.FullName) Option {
+	return optionFunc(func(s *settings) {
+		if s.dropUnknowns == nil {
+			s.dropUnknowns = make(map[protoreflect.FullName]bool, len(names))
+		}
+		for _, name := range names {
+			s.dropUnknowns[name] = true
+		}
+	})
+}
+
 // UpdateUnknowns specifies how to update unknown fields.
 type UpdateUnknowns int
 
@@ -101,11 +416,40 @@ const (
 	// UpdateReplacesUnknowns replaces any unknown fields on the destination message
 	// with any unknown fields from the source message when it's updated.
 	UpdateReplacesUnknowns
+	// UpdateMergesUnknowns merges any unknown fields from the source message into any
+	// unknown fields on the destination message when it's updated. Without descriptors,
+	// a field number that occurs more than once in either message is treated as repeated
+	// and its source entries are appended to its destination entries. Any other field number
+	// is treated as singular and its source entry replaces its destination entry. If either
+	// message's unknown fields are malformed, the source's are appended like UpdateAppendsUnknowns.
+	UpdateMergesUnknowns
 )
 
 // WithUpdateUnknowns returns an option that sets the given mode for updating unknown fields.
-func WithUpdateUnknowns(mode UpdateUnknowns) Option {
-	return optionFunc(func(s *settings) { s.updateUnknowns = mode })
+func WithUpdateUnknowns(mode UpdateUnknowns) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateUnknowns = mode })
+}
+
+// DiffPresence specifies how to compare a field that's present in only one message
+// when a mask is created from the differences between messages.
+type DiffPresence int
+
+const (
+	// DiffComparesPresence covers a field that's present in only one message,
+	// even if its value equals the absent field's default value.
+	// This is the default behavior.
+	DiffComparesPresence DiffPresence = iota
+	// DiffIgnoresPresence compares the values of a field that's present in only one message,
+	// using the absent field's default value, so that a field that's present with its default
+	// value and an absent field are equal. A message field that's present in only one message
+	// is compared with an empty message.
+	DiffIgnoresPresence
+)
+
+// WithDiffPresence returns an option that sets the given mode for comparing fields
+// that are present in only one message when a mask is created with Diff or DiffAll.
+func WithDiffPresence(mode DiffPresence) Option {
+	return optionFunc(func(s *settings) { s.diffPresence = mode })
 }
 
 // UpdateRepeated specifies how to update repeated fields.
@@ -120,8 +464,148 @@ const (
 )
 
 // WithUpdateRepeated returns an option that sets the given mode for updating repeated fields.
-func WithUpdateRepeated(mode UpdateRepeated) Option {
-	return optionFunc(func(s *settings) { s.updateRepeated = mode })
+func WithUpdateRepeated(mode UpdateRepeated) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateRepeated = mode })
+}
+
+// WithCloneDedupRepeated returns an option that sets whether duplicate elements are removed
+// from repeated fields when a message is cloned. The order of first occurrence is preserved
+// and message elements are compared with proto.Equal after they're masked.
+// By default, duplicates are preserved.
+func WithCloneDedupRepeated(dedup bool) Option {
+	return optionFunc(func(s *settings) { s.cloneDedupRepeated = dedup })
+}
+
+// WithDeterministicFieldOrder returns an option that sets whether Clone sets the fields of the
+// clone in field number order, rather than in the order in which the source message ranges over
+// them, so that serializers that encode fields in the order they were set produce reproducible
+// output. By default, fields are set in range order, which avoids sorting them.
+func WithDeterministicFieldOrder(deterministic bool) Option {
+	return optionFunc(func(s *settings) { s.deterministicOrder = deterministic })
+}
+
+// WithOmitEmptyContainers returns an option that sets whether Clone omits map and list fields
+// that are left empty, rather than setting them to empty values in the clone.
+func WithOmitEmptyContainers(omit bool) Option {
+	return optionFunc(func(s *settings) { s.omitEmptyContainers = omit })
+}
+
+// WithDropDefaultValuedMessages returns an option that sets whether masking or cloning a message drops
+// any message fields, message list elements, and message map values that are equal to their type's
+// default value, which is an empty message, once they're masked. It applies recursively, so a message
+// whose only populated fields are dropped is dropped too. Dropping list elements changes the indices
+// of the remaining elements. A message field in a oneof is retained, because it selects the oneof's case.
+// By default, default-valued messages are retained, because their presence may be meaningful.
+func WithDropDefaultValuedMessages(drop bool) Option {
+	return optionFunc(func(s *settings) { s.dropEmptyMessages = drop })
+}
+
+// WithDropEmptyMapValues returns an option that sets whether the entries of message-valued map fields
+// named by a path are dropped by Mask and Clone when their masked values are empty messages.
+// The values are masked first, so an entry is dropped if its value sub-mask leaves it empty.
+// It has no effect on scalar-valued maps.
+func WithDropEmptyMapValues(drop bool) Option {
+	return optionFunc(func(s *settings) { s.dropEmptyMapValues = drop })
+}
+
+// WithUpdateClearsEmptyMaps returns an option that sets whether a map field is cleared
+// from the destination message when it's left empty after an update.
+// By default, an empty map is left in place.
+func WithUpdateClearsEmptyMaps(clear bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateClearsEmptyMaps = clear })
+}
+
+// WithUpdateClearsEmptyLists returns an option that sets whether a list field is cleared
+// from the destination message when it's left empty after an update.
+// By default, an empty list is left in place.
+func WithUpdateClearsEmptyLists(clear bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateClearsEmptyLists = clear })
+}
+
+// WithUpdatePruneEmptyMessages returns an option that sets whether a message field is cleared from
+// the destination message when only its subfields are masked, it's absent from the source message,
+// and it's left empty after an update. By default, an emptied message field is left in place.
+// An empty message field is never created in the destination message when it didn't already exist.
+func WithUpdatePruneEmptyMessages(prune bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updatePrunesEmptyMessages = prune })
+}
+
+// WithWrapperPresence returns an option that sets whether the presence of a wrapper message field, such
+// as a google.protobuf.Int32Value, in the source message is the signal for updating its value when only
+// its value subfield is masked. If the wrapper is present, its value is set in the destination message,
+// even if it's the default value, and if it's absent, the wrapper is cleared from the destination message.
+// By default, the value subfield is updated like any other subfield, so an absent wrapper leaves an empty
+// wrapper in place, which is indistinguishable from a wrapper set to the default value, and a wrapper set
+// to the default value isn't created in the destination message.
+func WithWrapperPresence(presence bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateWrapperPresence = presence })
+}
+
+// WithUpdateReplacesMessages returns an option that sets whether a singular message field that's named
+// by the mask as a whole is replaced by the source message field rather than merged with it. If it's
+// replaced, the destination message field is cleared before it's updated, so nothing of its previous
+// value remains, such as its unknown fields or the elements of its lists in append mode. A message field
+// of which only subfields are named by the mask is still updated subfield by subfield, but any of those
+// subfields that's named as a whole is replaced. By default, message fields are merged.
+func WithUpdateReplacesMessages(replace bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateReplacesMessages = replace })
+}
+
+// WithUpdateSkipDefaults returns an option that sets whether Update leaves a scalar field without presence
+// unchanged if it has its default value in the source message. By default, fields with default values are updated.
+func WithUpdateSkipDefaults(skip bool) UpdateOption {
+	return updateOptionFunc(func(u *updateSettings) { u.updateSkipsDefaults = skip })
+}
+
+// WithRequireMaskedFieldsPresent returns an option that sets whether Update returns an error, without
+// modifying the destination message, if any field named by the mask is absent in the source message,
+// rather than clearing the field in the destination message. The fields of a message field named in
+// part are checked recursively, but map entries and list elements aren't checked individually. A scalar
+// field without presence is absent if it has its default value. By default, absent fields are cleared.
+func WithRequireMaskedFieldsPresent(require bool) Option {
+	return optionFunc(func(s *settings) { s.requirePresent = require })
+}
+
+// WithUpdateMapDeletePrefix returns an option that sets a prefix for tombstone keys in string-keyed maps.
+// On an update, a source map key beginning with the prefix isn't copied. Instead, the key with the prefix
+// stripped is deleted from the destination map, if it's covered by the mask. A tombstone takes precedence
+// over a value for the same key in the source map. By default, there are no tombstones.
+func WithUpdateMapDeletePrefix(prefix string) Option {
+	return optionFunc(func(s *settings) { s.mapDeletePrefix = prefix })
+}
+
+// WithLeafMerger returns an option that sets a function for merging scalar values on an update.
+// It's called with the descriptor of the field (or map value) and the destination and source values.
+// The destination value is invalid if the destination has no value or the value is appended to
+// a list; the elements of a replaced list are paired by index. If the merger returns an invalid
+// value, the destination is overwritten with the source value.
+// By default, the destination is always overwritten.
+func WithLeafMerger(merge func(fd protoreflect.FieldDescriptor, dst, src protoreflect.Value) protoreflect.Value) Option {
+	return optionFunc(func(s *settings) { s.leafMerger = merge })
+}
+
+// WithSanitizeFloats returns an option that sets whether float and double values that are NaN or infinite
+// are removed when a message is masked or cloned, such as to produce JSON without them. A singular field
+// with such a value is cleared, a repeated field retains only its finite elements, and a float-valued map
+// field retains only the entries with finite values. By default, every value is retained exactly.
+func WithSanitizeFloats(sanitize bool) Option {
+	return optionFunc(func(s *settings) { s.sanitizeFloats = sanitize })
+}
+
+// WithCloneTransform returns an option that sets a function that transforms each scalar value,
+// list element, and map value as it's copied by Clone and its variants. By default, values are copied as is.
+func WithCloneTransform(transform func(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value) Option {
+	return optionFunc(func(s *settings) { s.cloneTransform = transform })
+}
+
+// WithUpdateCondition returns an option that sets a condition for updating scalar fields.
+// It's called with the descriptor of the field and the source value before the field is
+// updated, and if it returns false, the destination field is left untouched. The condition
+// takes precedence over clearing fields that aren't set in the source, in which case it's
+// called with the field's default value, and over the leaf merger.
+// By default, every masked field is updated.
+func WithUpdateCondition(cond func(fd protoreflect.FieldDescriptor, src protoreflect.Value) bool) Option {
+	return optionFunc(func(s *settings) { s.updateCondition = cond })
 }
 
 type FieldMask[T proto.Message] struct {
@@ -129,10 +613,12 @@ type FieldMask[T proto.Message] struct {
 	msg *msgMask
 }
 
-func newFieldMaskT[T proto.Message](options []Option) *FieldMask[T] {
+func newFieldMaskT[T proto.Message](options []Option) (*FieldMask[T], error) {
 	fm := FieldMask[T]{
 		settings: settings{
 			lookupField: lookupTextField,
+			pathSep:     defaultPathSep,
+			state:       &parseState{},
 		},
 	}
 	for _, o := range options {
@@ -142,42 +628,329 @@ func newFieldMaskT[T proto.Message](options []Option) *FieldMask[T] {
 		var zero T
 		fm.rootDesc = zero.ProtoReflect().Descriptor()
 	}
+	if err := fm.settings.init(); err != nil {
+		return nil, err
+	}
 	fm.msg = newMsgMask(&fm.settings, fm.rootDesc)
-	return &fm
+	return &fm, nil
 }
 
 func New[T proto.Message](paths []string, options ...Option) (*FieldMask[T], error) {
-	fm := newFieldMaskT[T](options)
-	if len(paths) == 0 {
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
+		return nil, err
+	}
+	if err := fm.appendPaths(fm.unaliasPaths(paths)); err != nil {
+		return nil, err
+	}
+	return fm, nil
+}
+
+func FromProto[T proto.Message](fieldMask *fieldmaskpb.FieldMask, options ...Option) (*FieldMask[T], error) {
+	return New[T](fieldMask.GetPaths(), options...)
+}
+
+const fieldMaskFullName protoreflect.FullName = "google.protobuf.FieldMask"
+
+// FromMessageField returns a mask from the google.protobuf.FieldMask field at the given path of
+// the request message, such as "update_mask", like FromProto. The path names fields by their text
+// or JSON names, separated by dots, and may only traverse singular message fields. An unset field
+// is treated like a FieldMask without any paths.
+func FromMessageField[T proto.Message](req proto.Message, fieldPath string, options ...Option) (*FieldMask[T], error) {
+	if fieldPath == "" {
+		return nil, fmt.Errorf("invalid field path: %q", fieldPath)
+	}
+	s := settings{lookupField: lookupTextField}
+	msg := req.ProtoReflect()
+	for path := fieldPath; ; {
+		name, subpath, err := nextSegment(path, defaultPathSep)
+		if err != nil {
+			return nil, err
+		}
+		desc := msg.Descriptor()
+		_, fd, ok := s.lookupField(desc.Fields(), name)
+		if !ok {
+			return nil, s.unknownFieldError(desc, name)
+		}
+		if fd.IsList() || fd.IsMap() || fd.Message() == nil {
+			return nil, fmt.Errorf("invalid %v field: %q is not a singular message", desc.FullName(), name)
+		}
+		msg = msg.Get(fd).Message()
+		if subpath != "" {
+			path = subpath
+			continue
+		}
+		if fd.Message().FullName() != fieldMaskFullName {
+			return nil, fmt.Errorf("invalid mask field: %q is a %v; want %v", fieldPath, fd.Message().FullName(), fieldMaskFullName)
+		}
+		list := msg.Get(fd.Message().Fields().ByName("paths")).List()
+		paths := make([]string, list.Len())
+		for i := range paths {
+			paths[i] = list.Get(i).String()
+		}
+		return New[T](paths, options...)
+	}
+}
+
+// FromStruct returns a mask from a structpb value. A string value is parsed like Parse,
+// and a list value of strings is treated as the paths of New.
+func FromStruct[T proto.Message](v *structpb.Value, options ...Option) (*FieldMask[T], error) {
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return Parse[T](kind.StringValue, options...)
+	case *structpb.Value_ListValue:
+		values := kind.ListValue.GetValues()
+		paths := make([]string, len(values))
+		for i, elem := range values {
+			s, ok := elem.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return nil, fmt.Errorf("invalid mask list element %d: got %s; want string", i, structKindName(elem))
+			}
+			paths[i] = s.StringValue
+		}
+		return New[T](paths, options...)
+	default:
+		return nil, fmt.Errorf("invalid mask value: got %s; want string or list", structKindName(v))
+	}
+}
+
+// structKindName returns the name of the kind of the structpb value.
+func structKindName(v *structpb.Value) string {
+	switch v.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		return "null"
+	case *structpb.Value_NumberValue:
+		return "number"
+	case *structpb.Value_StringValue:
+		return "string"
+	case *structpb.Value_BoolValue:
+		return "bool"
+	case *structpb.Value_StructValue:
+		return "struct"
+	case *structpb.Value_ListValue:
+		return "list"
+	default:
+		return "nothing"
+	}
+}
+
+// DefaultMask returns the default mask declared by the (dev.bursavich.fieldmask.default_mask)
+// option of the message, as defined in the maskpb package, or by the option set with
+// WithDefaultMaskExtension. The option's values are treated as the paths of New.
+// If the message doesn't declare a default mask, the mask covers the whole message.
+func DefaultMask[T proto.Message](options ...Option) (*FieldMask[T], error) {
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
+		return nil, err
+	}
+	xt := fm.defaultMaskExt
+	if xt == nil {
+		xt = maskpb.E_DefaultMask
+	}
+	opts, ok := fm.rootDesc.Options().(*descriptorpb.MessageOptions)
+	if !ok || !proto.HasExtension(opts, xt) {
 		return fm, nil
 	}
-	if err := fm.msg.init(paths[0]); err != nil {
+	list := opts.ProtoReflect().Get(xt.TypeDescriptor()).List()
+	paths := make([]string, list.Len())
+	for i := range paths {
+		paths[i] = list.Get(i).String()
+	}
+	if err := fm.appendPaths(fm.unaliasPaths(paths)); err != nil {
+		return nil, fmt.Errorf("invalid default mask of %v: %w", fm.rootDesc.FullName(), err)
+	}
+	return fm, nil
+}
+
+// PopulatedMask returns a mask covering exactly the fields populated in the message.
+// It recurses into message fields and the message values of maps, and it covers the
+// present keys of maps and the entirety of non-empty lists. A message field that's
+// present but has no populated fields is covered as a whole. If the message has no
+// populated fields, the mask is empty and covers the whole message.
+func PopulatedMask[T proto.Message](msg T, options ...Option) (*FieldMask[T], error) {
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
+		return nil, err
+	}
+	if err := fm.appendPaths(fm.populatedPaths(msg.ProtoReflect())); err != nil {
+		return nil, err
+	}
+	return fm, nil
+}
+
+// MaskByKind returns a mask covering every field of the given kinds, such as every string field.
+// A list field matches the kind of its elements and a map field matches the kind of its values.
+// It recurses into message fields and the message elements and values of lists and maps that don't
+// match, except for a message type that's already along the path, because a recursive field can't be
+// covered at every depth, and google.protobuf.Struct, Value, and ListValue. It's an error if no fields match.
+func MaskByKind[T proto.Message](kinds []protoreflect.Kind, options ...Option) (*FieldMask[T], error) {
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
+		return nil, err
+	}
+	match := make(map[protoreflect.Kind]bool, len(kinds))
+	for _, kind := range kinds {
+		match[kind] = true
+	}
+	paths := fm.kindPaths(fm.rootDesc, match, []protoreflect.FullName{fm.rootDesc.FullName()})
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no %v fields of kinds: %v", fm.rootDesc.FullName(), kinds)
+	}
+	if err := fm.appendPaths(paths); err != nil {
+		return nil, err
+	}
+	return fm, nil
+}
+
+// Diff returns a mask covering every field that differs between the messages.
+// It's equivalent to DiffAll with a single variant.
+func Diff[T proto.Message](a, b T, options ...Option) (*FieldMask[T], error) {
+	return DiffAll(a, []T{b}, options...)
+}
+
+// DiffAll returns a mask covering every field that differs between the base message and any of
+// the variants. It recurses into message fields and the message values of maps, and it covers the
+// differing keys of maps and the entirety of differing lists. A map key that's present in only one
+// of the base and a variant is covered. A field that's present in only one of them is compared
+// according to the DiffPresence mode. If there are no differences, the mask is empty and covers
+// the whole message.
+func DiffAll[T proto.Message](base T, variants []T, options ...Option) (*FieldMask[T], error) {
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
 		return nil, err
 	}
+	a := base.ProtoReflect()
+	if a.Descriptor().FullName() != fm.rootDesc.FullName() {
+		return nil, fmt.Errorf("mismatched base message type: got %v; want %v", a.Descriptor().FullName(), fm.rootDesc.FullName())
+	}
+	var paths []string
+	for _, variant := range variants {
+		b := variant.ProtoReflect()
+		if b.Descriptor().FullName() != fm.rootDesc.FullName() {
+			return nil, fmt.Errorf("mismatched variant message type: got %v; want %v", b.Descriptor().FullName(), fm.rootDesc.FullName())
+		}
+		paths = append(paths, fm.diffPaths(a, b)...)
+	}
+	if err := fm.appendPaths(paths); err != nil {
+		return nil, err
+	}
+	return fm, nil
+}
+
+func (fm *FieldMask[T]) appendPaths(paths []string) error {
+	if len(paths) == 0 {
+		return fm.checkSubset("*") // The mask covers the whole message.
+	}
+	if err := fm.checkSubset(paths[0]); err != nil {
+		return err
+	}
+	if err := fm.msg.init(paths[0]); err != nil {
+		return err
+	}
 	for _, path := range paths[1:] {
+		if err := fm.checkPath(path); err != nil {
+			return err
+		}
 		if err := fm.msg.append(path); err != nil {
-			return nil, err
+			return err
 		}
 	}
-	return fm, nil
+	return fm.includeAlways()
 }
 
-func FromProto[T proto.Message](fieldMask *fieldmaskpb.FieldMask, options ...Option) (*FieldMask[T], error) {
-	return New[T](fieldMask.GetPaths(), options...)
+// checkPath returns an error if the path can't be appended to the mask
+// because it's overridden or it isn't covered by the parent mask.
+func (fm *FieldMask[T]) checkPath(path string) error {
+	if err := fm.checkOverride(path); err != nil {
+		return err
+	}
+	return fm.checkSubset(path)
+}
+
+// checkSubset returns an error if the mask must be a subset of a parent mask that doesn't cover the path.
+func (fm *FieldMask[T]) checkSubset(path string) error {
+	if fm.subsetOf == nil || fm.state.trusted || fm.subsetOf.covers(path) {
+		return nil
+	}
+	return fmt.Errorf("path %q isn't covered by the parent mask", path)
+}
+
+// coversPath returns a value indicating if the mask selects everything selected by the path.
+// It only reads the mask, so it's safe to call concurrently.
+func (fm *FieldMask[T]) coversPath(path string) bool {
+	return fm.msg.coversPath(path)
+}
+
+// checkOverride returns an error if overridden paths are rejected and the path overrides,
+// or is overridden by, the selection of the same list or map field by a path of the mask.
+func (fm *FieldMask[T]) checkOverride(path string) error {
+	if !fm.rejectOverrides || fm.state.trusted {
+		return nil
+	}
+	prefixes, err := fm.repeatedPrefixes(path)
+	if err != nil {
+		return nil // Invalid paths are reported when they're added.
+	}
+	var existing []string
+	if len(prefixes) > 0 {
+		existing = fm.msg.paths()
+	}
+	for _, p := range prefixes {
+		prefix, subpath := p[0], p[1]
+		wild := joinPath(prefix, "*", fm.pathSep)
+		whole := subpath == "" || subpath == "*"
+		for _, other := range existing {
+			if other == prefix || other == wild {
+				if !whole {
+					return fmt.Errorf("path %q is overridden by path %q", path, fm.aliasPaths([]string{other})[0])
+				}
+				continue
+			}
+			if whole && (hasPathPrefix(other, prefix, fm.pathSep) || strings.HasPrefix(other, prefix+"[")) {
+				return fmt.Errorf("path %q overrides path %q", path, fm.aliasPaths([]string{other})[0])
+			}
+		}
+	}
+	return nil
+}
+
+// includeAlways appends the always-included paths to the mask.
+func (fm *FieldMask[T]) includeAlways() error {
+	for _, path := range fm.alwaysInclude {
+		if err := fm.msg.append(fm.unalias(path)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func Parse[T proto.Message](paths string, options ...Option) (*FieldMask[T], error) {
-	fm := newFieldMaskT[T](options)
+	fm, err := newFieldMaskT[T](options)
+	if err != nil {
+		return nil, err
+	}
+	if fm.spaceSeparated {
+		if paths, err = spacesToCommas(paths); err != nil {
+			return nil, err
+		}
+	}
 	apply := fm.msg.init
 	for {
-		path, rest, err := nextPath(paths)
+		path, rest, err := nextPath(paths, fm.pathSep, fm.globExpansion)
 		if err != nil {
 			return nil, err
 		}
+		path = fm.unalias(path)
+		if err := fm.checkPath(path); err != nil {
+			return nil, err
+		}
 		if err := apply(path); err != nil {
 			return nil, err
 		}
 		if rest == "" {
+			if err := fm.includeAlways(); err != nil {
+				return nil, err
+			}
 			return fm, nil
 		}
 		paths = rest
@@ -185,17 +958,273 @@ func Parse[T proto.Message](paths string, options ...Option) (*FieldMask[T], err
 	}
 }
 
+// ValidatePaths returns an error if any of the paths doesn't resolve against the message descriptor
+// with the mask's settings, without modifying the mask. Unlike Parse and New, it doesn't stop at the
+// first invalid path, and each path is validated on its own, so a path isn't skipped because another
+// path, such as "*", covers it. The error joins the errors of every invalid path.
+func (fm *FieldMask[T]) ValidatePaths(paths []string) error {
+	var errs []error
+	s := fm.settings.withParseState()
+	for _, path := range paths {
+		if err := newMsgMask(&s, fm.rootDesc).init(fm.unalias(path)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid path %q: %w", path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (fm *FieldMask[T]) Append(path string) error {
+	path = fm.unalias(path)
+	if err := fm.checkPath(path); err != nil {
+		return err
+	}
 	return fm.msg.append(path)
 }
 
-func (fm *FieldMask[T]) Paths() []string {
+// AppendReport appends the path, like Append, and returns the paths of the mask that cover
+// something that wasn't covered before, such as the path itself or a wildcard path that subsumes
+// prior paths. It returns no paths if the appended path was already covered.
+func (fm *FieldMask[T]) AppendReport(path string) ([]string, error) {
+	if fm.msg.complete() {
+		return nil, fm.Append(path) // Everything is already covered.
+	}
+	before := fm.msg.paths()
+	if err := fm.Append(path); err != nil {
+		return nil, err
+	}
+	if fm.msg.complete() {
+		return []string{"*"}, nil
+	}
+	var added []string
+	for _, path := range fm.msg.paths() {
+		if !slices.ContainsFunc(before, func(prev string) bool { return pathCovers(prev, path, fm.pathSep) }) {
+			added = append(added, path)
+		}
+	}
+	return fm.aliasPaths(added), nil
+}
+
+// pathCovers returns a value indicating if the mask path covers the other path,
+// which is true if each of its segments is equal to, or a wildcard for, the
+// corresponding segment of the other path and it has no more segments.
+func pathCovers(mask, path string, sep rune) bool {
+	for mask != "" {
+		if path == "" {
+			return false
+		}
+		m, mrest, err := nextSegment(mask, sep)
+		if err != nil {
+			return mask == path
+		}
+		p, prest, err := nextSegment(path, sep)
+		if err != nil || (m != "*" && m != p) {
+			return false
+		}
+		mask, path = mrest, prest
+	}
+	return true
+}
+
+// AddFieldByNumber appends the fields of the root message with the given numbers.
+// It's the counterpart to Append for generated code that refers to fields by number.
+func (fm *FieldMask[T]) AddFieldByNumber(nums ...protoreflect.FieldNumber) error {
+	for _, num := range nums {
+		if err := fm.AddFieldPathByNumber(num); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddFieldPathByNumber appends the field at the path given by a sequence of field numbers.
+// Each number except the last must refer to a singular message field.
+func (fm *FieldMask[T]) AddFieldPathByNumber(nums ...protoreflect.FieldNumber) error {
+	if len(nums) == 0 {
+		return errors.New("empty field number path")
+	}
+	var path strings.Builder
+	desc := fm.msg.desc
+	for i, num := range nums {
+		if desc == nil {
+			return fmt.Errorf("invalid field number path: %v is not a singular message", nums[:i])
+		}
+		fd := desc.Fields().ByNumber(num)
+		if fd == nil {
+			return fmt.Errorf("unknown %v field number: %d", desc.FullName(), num)
+		}
+		if i > 0 {
+			path.WriteRune(fm.pathSep)
+		}
+		path.WriteString(fm.fieldKey(fd))
+		if fd.IsList() || fd.IsMap() {
+			desc = nil
+		} else {
+			desc = fd.Message()
+		}
+	}
+	if err := fm.checkPath(path.String()); err != nil {
+		return err
+	}
+	return fm.msg.append(path.String())
+}
+
+// Attach appends the paths of the sub-mask under the message field at the given path.
+// The field must be a singular message field of the same type as the root of the sub-mask.
+func (fm *FieldMask[T]) Attach(fieldPath string, sub interface{ Paths() []string }) error {
+	var desc protoreflect.MessageDescriptor
+	if r, ok := sub.(interface {
+		Descriptor() protoreflect.MessageDescriptor
+	}); ok {
+		desc = r.Descriptor()
+	}
+	paths := sub.Paths()
+	if r, ok := sub.(interface{ rawPaths() []string }); ok {
+		// The paths of a mask are aliased, but the parent's aliases don't apply to them.
+		paths = r.rawPaths()
+	}
+	return fm.attach(fieldPath, desc, paths)
+}
+
+// Graft appends the paths of the child mask under the message field of the parent mask at the given
+// path. It's the typed version of Attach: the field must be a singular message field of the child's
+// message type, and the masks must name fields and separate paths in the same way. Neither mask's
+// aliases apply to the child's paths; the parent's aliases only apply to the field path.
+func Graft[Parent, Child proto.Message](parent *FieldMask[Parent], fieldPath string, child *FieldMask[Child]) error {
+	if parent.fieldName != child.fieldName || parent.pathSep != child.pathSep {
+		return fmt.Errorf("incompatible child mask for field %q: mismatched field names or path separator", fieldPath)
+	}
+	return parent.attach(fieldPath, child.rootDesc, child.rawPaths())
+}
+
+// attach appends the paths under the message field at the given path. If desc isn't nil,
+// it must be the descriptor of the field's message type.
+func (fm *FieldMask[T]) attach(fieldPath string, desc protoreflect.MessageDescriptor, paths []string) error {
+	fieldPath = fm.unalias(fieldPath)
+	fd, err := fm.lookupFieldPath(fieldPath)
+	if err != nil {
+		return err
+	}
+	if fd.IsList() || fd.IsMap() || fd.Message() == nil {
+		return fmt.Errorf("invalid field path: %q is not a singular message", fieldPath)
+	}
+	if desc != nil && desc.FullName() != fd.Message().FullName() {
+		return fmt.Errorf("mismatched sub-mask type for field %q: got %v; want %v", fieldPath, desc.FullName(), fd.Message().FullName())
+	}
+	full := make([]string, len(paths))
+	for i, path := range paths {
+		if path != "*" {
+			full[i] = joinPath(fieldPath, path, fm.pathSep)
+		} else {
+			full[i] = fieldPath
+		}
+	}
+	// The paths are validated on a copy of the mask, so that the mask
+	// isn't changed unless every path can be appended to it.
+	scratch := fm.subMask(fm.msg.paths())
+	for _, path := range full {
+		if err := scratch.checkPath(path); err != nil {
+			return err
+		}
+		if err := scratch.msg.append(path); err != nil {
+			return err
+		}
+	}
+	fm.state.trusted = true
+	defer func() { fm.state.trusted = false }()
+	for _, path := range full {
+		if err := fm.msg.append(path); err != nil {
+			panic(fmt.Sprintf("fieldmask: internal error: failed to append validated path: %q: %v", path, err))
+		}
+	}
+	return nil
+}
+
+// SplitByTopLevel splits the mask into masks that each cover a single top-level field and its
+// selected subpaths. The masks are keyed by the names of the fields. If the mask covers the whole
+// message, every top-level field is covered in full by its own mask.
+func (fm *FieldMask[T]) SplitByTopLevel() map[string]*FieldMask[T] {
+	out := make(map[string]*FieldMask[T])
+	if fm.msg.complete() {
+		fds := fm.rootDesc.Fields()
+		for i, n := 0, fds.Len(); i < n; i++ {
+			if fd := fds.Get(i); fm.allow(fd) {
+				key := fm.fieldKey(fd)
+				out[key] = fm.subMask([]string{key})
+			}
+		}
+		return out
+	}
+	for key, fld := range fm.msg.fields {
+		out[key] = fm.subMask(appendSubpaths(nil, key, fld.paths(), fm.pathSep))
+	}
+	return out
+}
+
+// rawPaths returns the paths of the mask without aliases, or "*" if it covers the whole message.
+func (fm *FieldMask[T]) rawPaths() []string {
 	if paths := fm.msg.paths(); len(paths) > 0 {
 		return paths
 	}
 	return []string{"*"}
 }
 
+// subMask returns a new mask with the same settings that covers the given paths.
+func (fm *FieldMask[T]) subMask(paths []string) *FieldMask[T] {
+	sub := &FieldMask[T]{settings: fm.settings.withParseState()}
+	sub.msg = newMsgMask(&sub.settings, fm.rootDesc)
+	// The paths were validated when they were added to an existing mask, so they can be appended
+	// again, but the limits that they passed don't apply to their combination. The paths that are
+	// appended later are checked as usual.
+	sub.state.trusted = true
+	if err := sub.appendPaths(paths); err != nil {
+		panic(fmt.Sprintf("fieldmask: internal error: failed to append paths of existing mask: %v", err))
+	}
+	sub.state.trusted = false
+	return sub
+}
+
+// Union returns a new mask that covers the paths of both masks. The masks must have compatible
+// settings: the settings that determine how paths are interpreted and how messages are masked and
+// updated must be the same. Options that are functions, such as filters and callbacks, can't be
+// compared, so the union uses those of the receiver. If the receiver must be a subset of a parent mask,
+// so must the union. It returns an error if the paths of the masks can't be combined, such as
+// different slices of the same list.
+func (fm *FieldMask[T]) Union(other *FieldMask[T]) (*FieldMask[T], error) {
+	if err := fm.compatible(&other.settings); err != nil {
+		return nil, err
+	}
+	if fm.msg.complete() || other.msg.complete() {
+		if err := fm.checkSubset("*"); err != nil {
+			return nil, err
+		}
+		return fm.subMask(nil), nil
+	}
+	// The paths of the other mask may conflict with those of the receiver, such as with
+	// different slices of the same list, so they're appended to a copy of the receiver.
+	out := fm.subMask(fm.msg.paths())
+	out.state.trusted = true
+	defer func() { out.state.trusted = false }()
+	for _, path := range other.msg.paths() {
+		if err := fm.checkSubset(path); err != nil {
+			return nil, err
+		}
+		if err := out.msg.append(path); err != nil {
+			return nil, fmt.Errorf("incompatible path %q: %w", fm.aliasPaths([]string{path})[0], err)
+		}
+	}
+	return out, nil
+}
+
+// Descriptor returns the descriptor of the root message for which the mask was built.
+func (fm *FieldMask[T]) Descriptor() protoreflect.MessageDescriptor { return fm.rootDesc }
+
+func (fm *FieldMask[T]) Paths() []string {
+	if paths := fm.msg.paths(); len(paths) > 0 {
+		return fm.aliasPaths(paths)
+	}
+	return []string{"*"}
+}
+
 func (fm *FieldMask[T]) Proto() *fieldmaskpb.FieldMask {
 	return &fieldmaskpb.FieldMask{
 		Paths: fm.Paths(),
@@ -207,18 +1236,269 @@ func (fm *FieldMask[T]) String() string {
 }
 
 func (fm *FieldMask[T]) Mask(msg T) {
-	fm.msg.mask(msg.ProtoReflect())
+	fm.maskReflect(msg.ProtoReflect())
+}
+
+func (fm *FieldMask[T]) maskReflect(msg protoreflect.Message) {
+	fm.msg.mask(msg, "", nil)
+	fm.dropDefaultMessages(msg)
 }
 
 func (fm *FieldMask[T]) Clone(msg T) T {
-	return fm.msg.clone(msg.ProtoReflect()).Interface().(T)
+	out := fm.msg.clone(msg.ProtoReflect(), nil)
+	fm.dropDefaultMessages(out)
+	return out.Interface().(T)
+}
+
+// WouldModify returns a value indicating if masking the message in place with Mask would change it,
+// such as by clearing a populated field, map entry, or list element that isn't covered by the mask.
+// It stops at the first change it finds and doesn't modify the message.
+func (fm *FieldMask[T]) WouldModify(msg T) bool {
+	if fm.dropEmptyMessages {
+		// Whether a message is dropped depends on the masked values of its fields.
+		return !proto.Equal(msg, fm.Clone(msg))
+	}
+	return fm.msg.modifies(msg.ProtoReflect())
+}
+
+// CloneInto resets the destination message and copies the masked fields of the source message
+// into it, like Clone, so that the destination can be reused, such as from a pool. Nothing from
+// the previous contents of the destination is retained and bytes fields aren't aliased between
+// the destination and source.
+func (fm *FieldMask[T]) CloneInto(dst, src T) {
+	proto.Reset(dst)
+	fm.msg.cloneInto(dst.ProtoReflect(), src.ProtoReflect(), nil)
+	fm.dropDefaultMessages(dst.ProtoReflect())
 }
 
+// CloneChecked returns a masked clone of the message and a value
+// indicating if any field, including an unknown field, is populated in it.
+func (fm *FieldMask[T]) CloneChecked(msg T) (T, bool) {
+	out := fm.msg.clone(msg.ProtoReflect(), nil)
+	fm.dropDefaultMessages(out)
+	populated := len(out.GetUnknown()) > 0
+	if !populated {
+		out.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+			populated = true
+			return false
+		})
+	}
+	return out.Interface().(T), populated
+}
+
+// CoversPresent returns a value indicating if the path is covered by the mask and present
+// in the message. The path may name a specific map key or list index, for example
+// "map_string_message_field.foo.int32_field" or "repeated_message_field.2". A path that
+// doesn't name a value in the message's type isn't covered.
+func (fm *FieldMask[T]) CoversPresent(msg T, path string) bool {
+	if any(msg) == nil || !msg.ProtoReflect().IsValid() || path == "" {
+		return false
+	}
+	return fm.msg.covers(msg.ProtoReflect(), fm.unalias(path))
+}
+
+// MapKeys returns the sorted and formatted keys that are explicitly selected by the mask for the
+// map field at the given path and a value indicating if every key is selected, either by a wildcard
+// or because the map field or one of its ancestors is selected as a whole. If the map field isn't
+// selected, there are no keys. The path may only traverse singular message fields. It returns an
+// error if the path doesn't name a map field.
+func (fm *FieldMask[T]) MapKeys(fieldPath string) (keys []string, all bool, err error) {
+	fieldPath = fm.unalias(fieldPath)
+	fd, err := fm.lookupFieldPath(fieldPath)
+	if err != nil {
+		return nil, false, err
+	}
+	if !fd.IsMap() {
+		return nil, false, fmt.Errorf("invalid map field path: %q is not a map", fieldPath)
+	}
+	mm := fm.msg
+	for {
+		name, subpath, _ := nextSegment(fieldPath, fm.pathSep)
+		if mm.complete() {
+			return nil, true, nil
+		}
+		key, _, _ := fm.lookupField(mm.fldDescs, name)
+		fld, ok := mm.fields[key]
+		switch {
+		case !ok:
+			return nil, false, nil
+		case subpath == "":
+			keys, all := fld.(mapKeysMask).selectedKeys()
+			return keys, all, nil
+		}
+		mm, fieldPath = &fld.(*msgFieldMask).msgMask, subpath
+	}
+}
+
+// MaskJSON masks a message encoded as protojson and returns the masked message encoded as protojson.
+// The output uses the field names specified by the FieldName mode.
+func (fm *FieldMask[T]) MaskJSON(data []byte) ([]byte, error) {
+	msg := fm.newMessage()
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	fm.Mask(msg)
+	return protojson.MarshalOptions{
+		UseProtoNames: fm.fieldName == TextFieldName,
+	}.Marshal(msg)
+}
+
+// MaskWire masks a message encoded in the binary wire format and returns the masked message encoded
+// in the wire format. The records of top-level fields that aren't covered by the mask are dropped without
+// being decoded, and the rest of the message is decoded, masked, and encoded again, so unknown fields
+// are handled according to the MaskUnknowns mode.
+func (fm *FieldMask[T]) MaskWire(data []byte) ([]byte, error) {
+	if fields := fm.msg.fields; fields != nil {
+		fds := fm.rootDesc.Fields()
+		kept := make([]byte, 0, len(data))
+		for b := data; len(b) > 0; {
+			num, typ, n := protowire.ConsumeTag(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			m := protowire.ConsumeFieldValue(num, typ, b[n:])
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+			record := b[:n+m]
+			b = b[n+m:]
+			if fd := fds.ByNumber(num); fd != nil {
+				if _, ok := fields[fm.fieldKey(fd)]; !ok {
+					continue // The field is cleared anyway.
+				}
+			}
+			kept = append(kept, record...)
+		}
+		data = kept
+	}
+	msg := fm.newMessage()
+	if err := (proto.UnmarshalOptions{Resolver: fm.extResolver}).Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	fm.Mask(msg)
+	return proto.Marshal(msg)
+}
+
+// MarshalJSONMasked returns the masked fields of the message encoded as protojson with the given options,
+// without modifying the message. The output uses the field names specified by the FieldName mode, which
+// overrides the UseProtoNames option. Other options, such as EmitUnpopulated, apply as usual, so an
+// unpopulated field is emitted with its default value even if it isn't covered by the mask.
+func (fm *FieldMask[T]) MarshalJSONMasked(msg T, opts protojson.MarshalOptions) ([]byte, error) {
+	// TODO: Marshal a masked view of the message to avoid cloning it.
+	opts.UseProtoNames = fm.fieldName == TextFieldName
+	return opts.Marshal(fm.Clone(msg))
+}
+
+// newMessage returns a new empty message of the mask's type.
+func (fm *FieldMask[T]) newMessage() T {
+	var zero T
+	switch any(zero).(type) {
+	case nil, *dynamicpb.Message:
+		return any(dynamicpb.NewMessage(fm.rootDesc)).(T)
+	}
+	return zero.ProtoReflect().New().Interface().(T)
+}
+
+// Update updates the destination message with the masked fields of the source message.
+// It returns an error if the destination message is nil. A nil source message is
+// treated as an empty message.
 func (fm *FieldMask[T]) Update(dst, src T) error {
-	fm.msg.update(dst.ProtoReflect(), src.ProtoReflect())
+	return fm.update(dst, src, nil)
+}
+
+// UpdateWith updates the destination message with the masked fields of the source message,
+// like Update, but the given options override the mask's update settings for this call only.
+func (fm *FieldMask[T]) UpdateWith(dst, src T, options ...UpdateOption) error {
+	u := fm.updateSettings
+	for _, o := range options {
+		o.applyUpdateOption(&u)
+	}
+	return fm.update(dst, src, &callState{overrides: &u})
+}
+
+func (fm *FieldMask[T]) update(dst, src T, c *callState) error {
+	if any(dst) == nil || !dst.ProtoReflect().IsValid() {
+		return errNilDestination
+	}
+	c = fm.updateState(c)
+	dstMsg := dst.ProtoReflect()
+	srcMsg := dstMsg.Type().Zero()
+	if any(src) != nil {
+		srcMsg = src.ProtoReflect()
+	}
+	if err := fm.checkPresent(srcMsg); err != nil {
+		return err
+	}
+	fm.msg.update(dstMsg, srcMsg, c)
 	return nil
 }
 
+// checkPresent returns an error if masked fields are required to be present
+// in the source message and any of them are absent.
+func (fm *FieldMask[T]) checkPresent(src protoreflect.Message) error {
+	if !fm.requirePresent {
+		return nil
+	}
+	if paths := fm.msg.absentPaths(nil, src, ""); len(paths) > 0 {
+		return fmt.Errorf("masked fields absent in source: %s", strings.Join(fm.aliasPaths(paths), ", "))
+	}
+	return nil
+}
+
+// MaskReflect masks the message in place. It's the counterpart to Mask for callers holding
+// a reflective handle to the message. It returns an error if the message's descriptor
+// isn't the mask's root message descriptor.
+func (fm *FieldMask[T]) MaskReflect(m protoreflect.Message) error {
+	if m == nil {
+		return errNilDestination
+	}
+	if err := fm.checkDescriptor(m.Descriptor()); err != nil {
+		return err
+	}
+	if !m.IsValid() {
+		return nil // Nothing to mask
+	}
+	fm.msg.mask(m, "", nil)
+	fm.dropDefaultMessages(m)
+	return nil
+}
+
+// UpdateReflect updates the destination message with the masked fields of the source message.
+// It's the counterpart to Update for callers holding reflective handles to the messages.
+// It returns an error if the destination message is nil or invalid, or if either message's
+// descriptor isn't the mask's root message descriptor. A nil source message is treated as
+// an empty message.
+func (fm *FieldMask[T]) UpdateReflect(dst, src protoreflect.Message) error {
+	if dst == nil || !dst.IsValid() {
+		return errNilDestination
+	}
+	if err := fm.checkDescriptor(dst.Descriptor()); err != nil {
+		return err
+	}
+	if src == nil {
+		src = dst.Type().Zero()
+	} else if err := fm.checkDescriptor(src.Descriptor()); err != nil {
+		return err
+	}
+	if err := fm.checkPresent(src); err != nil {
+		return err
+	}
+	fm.msg.update(dst, src, fm.updateState(nil))
+	return nil
+}
+
+// checkDescriptor returns an error if the descriptor isn't the root message descriptor.
+func (fm *FieldMask[T]) checkDescriptor(desc protoreflect.MessageDescriptor) error {
+	switch {
+	case desc == fm.rootDesc:
+		return nil
+	case desc.FullName() != fm.rootDesc.FullName():
+		return fmt.Errorf("mismatched message type: got %v; want %v", desc.FullName(), fm.rootDesc.FullName())
+	default:
+		return fmt.Errorf("mismatched message descriptor: %v isn't the mask's descriptor", desc.FullName())
+	}
+}
+
 type fieldMask interface {
 	// complete returns a value indicating if the full value is retained.
 	complete() bool
@@ -228,13 +1508,20 @@ type fieldMask interface {
 	append(path string) error
 	// paths returns the simplified paths of the mask.
 	paths() []string
+	// coversPath returns a value indicating if the mask selects everything selected by the subpath.
+	coversPath(path string) bool
 
 	// mask masks the value in place.
-	mask(parent protoreflect.Message, value protoreflect.Value)
+	mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState)
 	// update updates the parent with the masked version of the value.
-	update(parent protoreflect.Message, value protoreflect.Value, exists bool)
+	update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState)
 	// clone returns a cloned and masked version of the value.
-	clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value
+	clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value
+	// covers returns a value indicating if the subpath of the present value
+	// is covered by the mask and present in the value.
+	covers(value protoreflect.Value, path string) bool
+	// modifies returns a value indicating if masking the present value in place would change it.
+	modifies(value protoreflect.Value) bool
 }
 
 func newFieldMask(settings *settings, desc protoreflect.FieldDescriptor) fieldMask {
@@ -247,5 +1534,5 @@ func newFieldMask(settings *settings, desc protoreflect.FieldDescriptor) fieldMa
 	if desc.Message() != nil {
 		return newMsgFieldMask(settings, desc)
 	}
-	return newScalarFieldMask(desc)
+	return newScalarFieldMask(settings, desc)
 }
@@ -7,16 +7,25 @@
 package fieldmask
 
 import (
+	"encoding/json"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"bursavich.dev/fieldmask/internal/testpb"
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var protoCmp = protocmp.Transform()
@@ -629,4 +638,2092 @@ func TestPaths(t *testing.T) {
 			"map_int32_message_field.11",
 		},
 	}.run(t)
+
+	pathTest{
+		input: joinMasks(
+			"map_bool_string_field.1",
+			"map_bool_message_field.0.int32_field",
+			"map_bool_message_field.`T`",
+		),
+		paths: []string{
+			"map_bool_message_field.false.int32_field",
+			"map_bool_message_field.true",
+			"map_bool_string_field.true",
+		},
+	}.run(t)
+
+	pathTest{
+		name: "map_bool_string_field:numeric",
+		input: joinMasks(
+			"map_bool_string_field.true",
+			"map_bool_message_field.f.int32_field",
+			"map_bool_message_field.1",
+		),
+		opts: []Option{WithBoolKeyStyle(NumericBoolKeys)},
+		paths: []string{
+			"map_bool_message_field.0.int32_field",
+			"map_bool_message_field.1",
+			"map_bool_string_field.1",
+		},
+	}.run(t)
+
+	pathTest{
+		input: joinMasks(
+			"map_int64_string_field.`-1`",
+			"map_sint32_message_field.`-2`.int32_field",
+			"map_int32_string_field.-3",
+		),
+		paths: []string{
+			"map_int32_string_field.-3",
+			"map_int64_string_field.-1",
+			"map_sint32_message_field.-2.int32_field",
+		},
+	}.run(t)
+
+	pathTest{
+		name: "percent-encoding",
+		input: joinMasks(
+			"map_string_string_field.a%2Fb",
+			"map_string_string_field.a%2Eb",
+			"map_string_string_field.a%20b",
+			"map_string_message_field.%2A.int32_field",
+			"map_string_message_field.``",
+		),
+		opts: []Option{WithKeyEncoding(PercentEncoding)},
+		paths: []string{
+			"map_string_message_field.``",
+			"map_string_message_field.%2A.int32_field",
+			"map_string_string_field.a%20b",
+			"map_string_string_field.a%2Eb",
+			"map_string_string_field.a%2Fb",
+		},
+	}.run(t)
+
+	pathTest{
+		name:  "percent-encoding:invalid",
+		input: "map_string_string_field.a%2",
+		opts:  []Option{WithKeyEncoding(PercentEncoding)},
+		err:   true,
+	}.run(t)
+
+	pathTest{
+		name: "slash-separator",
+		input: joinMasks(
+			"message_field/message_field/int32_field",
+			"map_string_message_field/`a/b`/string_field",
+			"map_string_string_field/a.b",
+		),
+		opts: []Option{WithPathSeparator('/')},
+		paths: []string{
+			"map_string_message_field/`a/b`/string_field",
+			"map_string_string_field/a.b",
+			"message_field/message_field/int32_field",
+		},
+	}.run(t)
+
+	pathTest{
+		name:  "slash-separator:dot",
+		input: "message_field.int32_field",
+		opts:  []Option{WithPathSeparator('/')},
+		err:   true,
+	}.run(t)
+
+	for _, sep := range []rune{',', '*', '`', '#', '(', ')', '[', ']', ':', '\n', 0x110000} {
+		if _, err := Parse[*testpb.Message]("int32_field", WithPathSeparator(sep)); err == nil {
+			t.Errorf("Parse: expected error for invalid path separator: %q", sep)
+		}
+	}
+}
+
+func TestAttach(t *testing.T) {
+	sub, err := Parse[*testpb.Message]("int32_field,string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse sub-mask: %v", err)
+	}
+	fm, err := Parse[*testpb.Message]("bool_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if err := fm.Attach("message_field.message_field", sub); err != nil {
+		t.Fatalf("Attach: unexpected error: %v", err)
+	}
+	want := []string{
+		"bool_field",
+		"message_field.message_field.int32_field",
+		"message_field.message_field.string_field",
+	}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+
+	for _, path := range []string{
+		"",
+		"invalid_field",
+		"int32_field",
+		"repeated_message_field",
+		"map_string_message_field",
+		"message_field.",
+	} {
+		if err := fm.Attach(path, sub); err == nil {
+			t.Errorf("Attach: expected error for field path: %q", path)
+		}
+	}
+
+	other, err := Parse[*fieldmaskpb.FieldMask]("paths")
+	if err != nil {
+		t.Fatalf("Failed to parse mismatched sub-mask: %v", err)
+	}
+	if err := fm.Attach("message_field", other); err == nil {
+		t.Errorf("Attach: expected error for mismatched sub-mask type")
+	}
+
+	// The mask is unchanged unless every path is appended.
+	if err := fm.Attach("message_field", pathList{"int64_field", "invalid_field"}); err == nil {
+		t.Errorf("Attach: expected error for invalid sub-mask path")
+	}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff after failed Attach:\n%s", diff)
+	}
+
+	// The paths of a sub-mask are attached without its aliases.
+	aliased, err := Parse[*testpb.Message]("name", WithAlias(map[string]string{"name": "string_field"}))
+	if err != nil {
+		t.Fatalf("Failed to parse aliased sub-mask: %v", err)
+	}
+	if err := fm.Attach("message_field", aliased); err != nil {
+		t.Fatalf("Attach: unexpected error for aliased sub-mask: %v", err)
+	}
+	want = append(want, "message_field.string_field")
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff after aliased Attach:\n%s", diff)
+	}
+}
+
+// A pathList is a list of paths.
+type pathList []string
+
+func (p pathList) Paths() []string { return p }
+
+func TestGraft(t *testing.T) {
+	child, err := Parse[*testpb.Message]("int32_field,message_field.string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse child mask: %v", err)
+	}
+	parent, err := Parse[*testpb.Message]("bool_field")
+	if err != nil {
+		t.Fatalf("Failed to parse parent mask: %v", err)
+	}
+	if err := Graft(parent, "message_field", child); err != nil {
+		t.Fatalf("Graft: unexpected error: %v", err)
+	}
+	complete, err := Parse[*testpb.Message]("*")
+	if err != nil {
+		t.Fatalf("Failed to parse complete mask: %v", err)
+	}
+	if err := Graft(parent, "message_field.message_field.message_field", complete); err != nil {
+		t.Fatalf("Graft: unexpected error: %v", err)
+	}
+	want := []string{
+		"bool_field",
+		"message_field.int32_field",
+		"message_field.message_field.message_field",
+		"message_field.message_field.string_field",
+	}
+	if diff := cmp.Diff(want, parent.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+
+	for _, path := range []string{"", "invalid_field", "int32_field", "repeated_message_field"} {
+		if err := Graft(parent, path, child); err == nil {
+			t.Errorf("Graft: expected error for field path: %q", path)
+		}
+	}
+	other, err := Parse[*fieldmaskpb.FieldMask]("paths")
+	if err != nil {
+		t.Fatalf("Failed to parse mismatched child mask: %v", err)
+	}
+	if err := Graft(parent, "message_field", other); err == nil {
+		t.Error("Graft: expected error for mismatched child mask type")
+	}
+	jsonChild, err := Parse[*testpb.Message]("int32Field", WithFieldName(JSONFieldName, false))
+	if err != nil {
+		t.Fatalf("Failed to parse JSON child mask: %v", err)
+	}
+	if err := Graft(parent, "message_field", jsonChild); err == nil {
+		t.Error("Graft: expected error for mismatched field names")
+	}
+}
+
+func TestMaskJSON(t *testing.T) {
+	const input = `{"int32Field": 3, "stringField": "foo", "messageField": {"int32Field": 4, "boolField": true}}`
+	tests := []struct {
+		name string
+		opts []Option
+		want map[string]any
+	}{
+		{
+			name: "text",
+			want: map[string]any{
+				"int32_field":   3.0,
+				"message_field": map[string]any{"int32_field": 4.0},
+			},
+		},
+		{
+			name: "json",
+			opts: []Option{WithFieldName(JSONFieldName, false)},
+			want: map[string]any{
+				"int32Field":   3.0,
+				"messageField": map[string]any{"int32Field": 4.0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("int32_field,message_field.int32_field", tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			out, err := fm.MaskJSON([]byte(input))
+			if err != nil {
+				t.Fatalf("MaskJSON: unexpected error: %v", err)
+			}
+			var got map[string]any
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatalf("Failed to unmarshal output: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("MaskJSON: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("dynamic", func(t *testing.T) {
+		desc := (&testpb.Message{}).ProtoReflect().Descriptor()
+		fm, err := Parse[*dynamicpb.Message]("string_field", WithMessageDescriptor(desc))
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %v", err)
+		}
+		out, err := fm.MaskJSON([]byte(input))
+		if err != nil {
+			t.Fatalf("MaskJSON: unexpected error: %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(out, &got); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if diff := cmp.Diff(map[string]any{"string_field": "foo"}, got); diff != "" {
+			t.Fatalf("MaskJSON: unexpected diff:\n%s", diff)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fm, err := Parse[*testpb.Message]("string_field")
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %v", err)
+		}
+		if _, err := fm.MaskJSON([]byte(`{"invalid_field": 1}`)); err == nil {
+			t.Fatal("MaskJSON: expected error")
+		}
+	})
+}
+
+func TestMarshalJSONMasked(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field:   3,
+		StringField:  "foo",
+		MessageField: &testpb.Message{Int32Field: 4, BoolField: true},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		json protojson.MarshalOptions
+		want map[string]any
+	}{
+		{
+			name: "text",
+			json: protojson.MarshalOptions{UseProtoNames: false},
+			want: map[string]any{
+				"int32_field":   3.0,
+				"message_field": map[string]any{"int32_field": 4.0},
+			},
+		},
+		{
+			name: "json",
+			opts: []Option{WithFieldName(JSONFieldName, false)},
+			json: protojson.MarshalOptions{UseProtoNames: true},
+			want: map[string]any{
+				"int32Field":   3.0,
+				"messageField": map[string]any{"int32Field": 4.0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("int32_field,message_field.int32_field", tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			input := clone(msg)
+			out, err := fm.MarshalJSONMasked(input, tt.json)
+			if err != nil {
+				t.Fatalf("MarshalJSONMasked: unexpected error: %v", err)
+			}
+			var got map[string]any
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatalf("Failed to unmarshal output: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("MarshalJSONMasked: unexpected diff:\n%s", diff)
+			}
+			if diff := protoDiff(msg, input); diff != "" {
+				t.Fatalf("MarshalJSONMasked: modified message:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("emit-unpopulated", func(t *testing.T) {
+		fm, err := Parse[*testpb.Message]("int32_field,string_field")
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %v", err)
+		}
+		out, err := fm.MarshalJSONMasked(&testpb.Message{Int32Field: 3}, protojson.MarshalOptions{EmitUnpopulated: true})
+		if err != nil {
+			t.Fatalf("MarshalJSONMasked: unexpected error: %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(out, &got); err != nil {
+			t.Fatalf("Failed to unmarshal output: %v", err)
+		}
+		if got["int32_field"] != 3.0 || got["string_field"] != "" {
+			t.Fatalf("MarshalJSONMasked: unexpected output: %s", out)
+		}
+	})
+}
+
+// Masked-out fields with presence must be cleared rather than set to zero values,
+// so that protojson omits them instead of emitting them as default values.
+func TestMaskJSONPresence(t *testing.T) {
+	msg := &testpb.Proto2Message{
+		BoolField:    proto.Bool(false),
+		StringField:  proto.String(""),
+		Int32Field:   proto.Int32(0),
+		Int64Field:   proto.Int64(0),
+		EnumField:    testpb.Proto2Message_COLOR_UNSPECIFIED.Enum(),
+		MessageField: &testpb.Proto2Message{Int32Field: proto.Int32(0), StringField: proto.String("")},
+	}
+	// Empty lists and maps are default values without presence, so they're emitted.
+	empty := func(m map[string]any) map[string]any {
+		for _, name := range []string{"mapStringEnumField", "mapStringMessageField", "mapStringStringField"} {
+			m[name] = map[string]any{}
+		}
+		for _, name := range []string{"repeatedEnumField", "repeatedInt32Field", "repeatedMessageField"} {
+			m[name] = []any{}
+		}
+		return m
+	}
+	want := empty(map[string]any{
+		"boolField":    false,
+		"int64Field":   "0",
+		"messageField": empty(map[string]any{"int32Field": 0.0}),
+	})
+	fm, err := Parse[*testpb.Proto2Message]("bool_field,int64_field,message_field.int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	masked := clone(msg)
+	fm.Mask(masked)
+	for name, m := range map[string]*testpb.Proto2Message{
+		"Mask":  masked,
+		"Clone": fm.Clone(msg),
+	} {
+		out, err := protojson.MarshalOptions{EmitDefaultValues: true}.Marshal(m)
+		if err != nil {
+			t.Fatalf("%s: failed to marshal message: %v", name, err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(out, &got); err != nil {
+			t.Fatalf("%s: failed to unmarshal output: %v", name, err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%s: unexpected diff:\n%s", name, diff)
+		}
+		if m.StringField != nil || m.Int32Field != nil || m.EnumField != nil || m.MessageField.StringField != nil {
+			t.Errorf("%s: masked-out fields are present: %v", name, m)
+		}
+	}
+}
+
+func TestDefaultMask(t *testing.T) {
+	fm, err := DefaultMask[*testpb.Proto2DefaultMaskMessage]()
+	if err != nil {
+		t.Fatalf("DefaultMask: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"int32_field", "message_field.string_field"}, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+	msg := &testpb.Proto2DefaultMaskMessage{
+		Int32Field:  proto.Int32(1),
+		StringField: proto.String("foo"),
+		MessageField: &testpb.Proto2DefaultMaskMessage{
+			Int32Field:  proto.Int32(2),
+			StringField: proto.String("bar"),
+		},
+	}
+	want := &testpb.Proto2DefaultMaskMessage{
+		Int32Field:   proto.Int32(1),
+		MessageField: &testpb.Proto2DefaultMaskMessage{StringField: proto.String("bar")},
+	}
+	if diff := protoDiff(want, fm.Clone(msg)); diff != "" {
+		t.Fatalf("Clone: unexpected diff:\n%s", diff)
+	}
+
+	if _, err := DefaultMask[*testpb.Proto2DefaultMaskMessage](WithFieldName(JSONFieldName, true)); err == nil {
+		t.Fatal("DefaultMask: expected error for mismatched field names")
+	}
+
+	fm2, err := DefaultMask[*testpb.Message]()
+	if err != nil {
+		t.Fatalf("DefaultMask: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"*"}, fm2.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+
+	fm3, err := DefaultMask[*testpb.Proto2DefaultMaskMessage](WithDefaultMaskExtension(testpb.E_CustomDefaultMask))
+	if err != nil {
+		t.Fatalf("DefaultMask: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"string_field"}, fm3.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+	if _, err := DefaultMask[*testpb.Proto2DefaultMaskMessage](WithDefaultMaskExtension(testpb.E_RepeatedStringExt)); err == nil {
+		t.Fatal("DefaultMask: expected error for extension of another message")
+	}
+}
+
+func TestMaskByKind(t *testing.T) {
+	fm, err := MaskByKind[*testpb.Proto2Message]([]protoreflect.Kind{protoreflect.EnumKind})
+	if err != nil {
+		t.Fatalf("MaskByKind: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"enum_field", "map_string_enum_field", "repeated_enum_field"}, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+
+	fds, err := MaskByKind[*descriptorpb.FileDescriptorProto]([]protoreflect.Kind{protoreflect.StringKind})
+	if err != nil {
+		t.Fatalf("MaskByKind: unexpected error: %v", err)
+	}
+	paths := fds.Paths()
+	for _, path := range []string{
+		"name",
+		"dependency",
+		"message_type.*.name",
+		"message_type.*.field.*.name",
+		"message_type.*.field.*.type_name",
+		"options.java_package",
+	} {
+		if !slices.Contains(paths, path) {
+			t.Errorf("Paths: missing %q", path)
+		}
+	}
+	for _, path := range paths {
+		if strings.HasPrefix(path, "message_type.*.nested_type") {
+			t.Errorf("Paths: unexpected recursive path: %q", path)
+		}
+	}
+	msg := &descriptorpb.FileDescriptorProto{
+		Name:   proto.String("foo.proto"),
+		Syntax: proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Foo"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:   proto.String("bar"),
+				Number: proto.Int32(1),
+				Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+	}
+	want := &descriptorpb.FileDescriptorProto{
+		Name:   proto.String("foo.proto"),
+		Syntax: proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Foo"),
+			Field: []*descriptorpb.FieldDescriptorProto{{Name: proto.String("bar")}},
+		}},
+	}
+	if diff := protoDiff(want, fds.Clone(msg)); diff != "" {
+		t.Errorf("Clone: unexpected diff:\n%s", diff)
+	}
+
+	if _, err := MaskByKind[*testpb.Message]([]protoreflect.Kind{protoreflect.EnumKind}); err == nil {
+		t.Error("MaskByKind: expected error for no matching fields")
+	}
+}
+
+func TestPopulatedMask(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field:   1,
+		MessageField: &testpb.Message{StringField: "foo"},
+		RepeatedMessageField: []*testpb.Message{
+			{Int32Field: 2},
+		},
+		MapStringStringField: map[string]string{
+			"foo": "foo",
+			"a.b": "a.b",
+		},
+		MapInt32MessageField: map[int32]*testpb.Message{
+			-1: {BoolField: true},
+			2:  {},
+		},
+		OneofField: &testpb.Message_MessageOneofField{MessageOneofField: &testpb.Message{}},
+	}
+	fm, err := PopulatedMask(msg)
+	if err != nil {
+		t.Fatalf("PopulatedMask: unexpected error: %v", err)
+	}
+	want := []string{
+		"int32_field",
+		"map_int32_message_field.-1.bool_field",
+		"map_int32_message_field.2",
+		"map_string_string_field.`a.b`",
+		"map_string_string_field.foo",
+		"message_field.string_field",
+		"message_oneof_field",
+		"repeated_message_field",
+	}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+	if diff := protoDiff(msg, fm.Clone(msg)); diff != "" {
+		t.Fatalf("Clone: unexpected diff:\n%s", diff)
+	}
+
+	fm, err = PopulatedMask(&testpb.Message{}, WithFieldName(JSONFieldName, true))
+	if err != nil {
+		t.Fatalf("PopulatedMask: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"*"}, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff:\n%s", diff)
+	}
+}
+
+func TestUpdateNil(t *testing.T) {
+	for _, mask := range []string{
+		"*",
+		"int32_field",
+		"message_field",
+		"message_field.int32_field",
+		"repeated_int32_field",
+		"repeated_message_field.*.int32_field",
+		"map_string_string_field",
+		"map_string_message_field.foo.int32_field",
+	} {
+		t.Run(mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](mask)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			if err := fm.Update(nil, testMsg); err == nil {
+				t.Error("Update: expected error for nil dst")
+			}
+			if err := fm.Update(nil, nil); err == nil {
+				t.Error("Update: expected error for nil dst and src")
+			}
+			dst := clone(testMsg)
+			if err := fm.Update(dst, nil); err != nil {
+				t.Fatalf("Update: unexpected error for nil src: %v", err)
+			}
+			want := clone(testMsg)
+			if err := fm.Update(want, &testpb.Message{}); err != nil {
+				t.Fatalf("Update: unexpected error for empty src: %v", err)
+			}
+			if diff := protoDiff(want, dst); diff != "" {
+				t.Fatalf("Update: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("interface", func(t *testing.T) {
+		desc := (&testpb.Message{}).ProtoReflect().Descriptor()
+		fm, err := Parse[proto.Message]("int32_field", WithMessageDescriptor(desc))
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %v", err)
+		}
+		if err := fm.Update(nil, testMsg); err == nil {
+			t.Error("Update: expected error for nil dst")
+		}
+		dst := clone(testMsg)
+		if err := fm.Update(dst, nil); err != nil {
+			t.Fatalf("Update: unexpected error for nil src: %v", err)
+		}
+		if dst.Int32Field != 0 {
+			t.Errorf("Update: unexpected int32_field: got: %v; want: 0", dst.Int32Field)
+		}
+	})
+}
+
+func TestStrictFieldNameHint(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		opts []Option
+		want string
+	}{
+		{
+			name: "text",
+			path: "message_field.int32Field",
+			opts: []Option{WithFieldName(TextFieldName, true)},
+			want: `unknown dev.bursavich.fieldmask.test.Message text field: "int32Field"; did you mean "int32_field" or enable JSON field names?`,
+		},
+		{
+			name: "json",
+			path: "messageField.int32_field",
+			opts: []Option{WithFieldName(JSONFieldName, true)},
+			want: `unknown dev.bursavich.fieldmask.test.Message JSON field: "int32_field"; did you mean "int32Field" or enable text field names?`,
+		},
+		{
+			name: "unknown",
+			path: "invalid_field",
+			opts: []Option{WithFieldName(TextFieldName, true)},
+			want: `unknown dev.bursavich.fieldmask.test.Message field: "invalid_field"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse[*testpb.Message](tt.path, tt.opts...)
+			if err == nil {
+				t.Fatal("Parse: expected error")
+			}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("Parse: unexpected error:\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlwaysInclude(t *testing.T) {
+	always := WithAlwaysInclude("string_field", "message_field.int32_field")
+	msg := &testpb.Message{
+		Int32Field:   1,
+		StringField:  "etag",
+		BoolField:    true,
+		MessageField: &testpb.Message{Int32Field: 2, StringField: "b"},
+	}
+	for _, tt := range []basicTest{
+		{
+			name:  "parse",
+			mask:  "bool_field",
+			opts:  []Option{always},
+			paths: []string{"bool_field", "message_field.int32_field", "string_field"},
+			msg:   msg,
+			out: &testpb.Message{
+				StringField:  "etag",
+				BoolField:    true,
+				MessageField: &testpb.Message{Int32Field: 2},
+			},
+		},
+		{
+			name:  "covered",
+			mask:  "message_field",
+			opts:  []Option{always},
+			paths: []string{"message_field", "string_field"},
+			msg:   msg,
+			out: &testpb.Message{
+				StringField:  "etag",
+				MessageField: &testpb.Message{Int32Field: 2, StringField: "b"},
+			},
+		},
+		{
+			name:  "complete",
+			mask:  "*",
+			opts:  []Option{always},
+			paths: []string{"*"},
+			msg:   msg,
+			out:   msg,
+		},
+		{
+			name:  "alias",
+			mask:  "bool_field",
+			opts:  []Option{WithAlias(map[string]string{"etag": "string_field"}), WithAlwaysInclude("etag")},
+			paths: []string{"bool_field", "etag"},
+			msg:   msg,
+			out:   &testpb.Message{StringField: "etag", BoolField: true},
+		},
+		{
+			name: "invalid",
+			mask: "bool_field",
+			opts: []Option{WithAlwaysInclude("unknown_field")},
+			err:  true,
+		},
+	} {
+		tt.run(t)
+	}
+
+	fm, err := New[*testpb.Message]([]string{"bool_field"}, always)
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	want := []string{"bool_field", "message_field.int32_field", "string_field"}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Errorf("New: unexpected paths (-want +got):\n%s", diff)
+	}
+	fm, err = New[*testpb.Message](nil, always)
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"*"}, fm.Paths()); diff != "" {
+		t.Errorf("New(nil): unexpected paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestReservedFieldName(t *testing.T) {
+	_, err := Parse[*testpb.Proto2Message]("message_field.removed_field")
+	if err == nil {
+		t.Fatal("Parse: expected error")
+	}
+	if got, want := err.Error(), `dev.bursavich.fieldmask.test.Proto2Message field "removed_field" is reserved`; got != want {
+		t.Errorf("Parse: unexpected error:\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestDescriptor(t *testing.T) {
+	want := (&testpb.Message{}).ProtoReflect().Descriptor()
+	fm, err := Parse[*testpb.Message]("int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if got := fm.Descriptor(); got != want {
+		t.Errorf("Descriptor: got: %v; want: %v", got.FullName(), want.FullName())
+	}
+	if got := fm.msg.desc; got != want {
+		t.Errorf("Descriptor: unexpected msgMask descriptor: got: %v; want: %v", got.FullName(), want.FullName())
+	}
+
+	dyn, err := Parse[*dynamicpb.Message]("int32_field", WithMessageDescriptor(want))
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if got := dyn.Descriptor(); got != want {
+		t.Errorf("Descriptor: got: %v; want: %v", got.FullName(), want.FullName())
+	}
+}
+
+func TestOnClear(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field:          1,
+		StringField:         "foo",
+		MapInt32StringField: map[int32]string{1: "a", 2: "b"},
+		MessageField: &testpb.Message{
+			Int32Field:  2,
+			StringField: "bar",
+		},
+		RepeatedMessageField: []*testpb.Message{
+			{Int32Field: 3, StringField: "baz"},
+		},
+		MapStringMessageField: map[string]*testpb.Message{
+			"x": {Int32Field: 4, StringField: "qux"},
+		},
+	}
+	var got []string
+	fm, err := Parse[*testpb.Message](
+		"int32_field,map_int32_string_field.1,message_field.int32_field,repeated_message_field.*.int32_field,map_string_message_field.*.int32_field",
+		WithOnClear(func(path string, fd protoreflect.FieldDescriptor) {
+			got = append(got, path)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	fm.Mask(msg)
+	want := []string{
+		"string_field",
+		"map_int32_string_field.2",
+		"message_field.string_field",
+		"repeated_message_field.0.string_field",
+		"map_string_message_field.x.string_field",
+	}
+	slices.Sort(got)
+	slices.Sort(want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("OnClear: unexpected paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestOnUpdateField(t *testing.T) {
+	dst := &testpb.Message{
+		Int32Field:   1,
+		StringField:  "foo",
+		BoolField:    true,
+		MessageField: &testpb.Message{Int32Field: 2, MessageField: &testpb.Message{Int32Field: 3}},
+	}
+	src := &testpb.Message{
+		Int32Field:           1, // unchanged
+		MapStringStringField: map[string]string{"a": "b"},
+		MessageField: &testpb.Message{
+			MessageField: &testpb.Message{Int32Field: 3, StringField: "bar"},
+		},
+		RepeatedMessageField: []*testpb.Message{{Int32Field: 4}},
+	}
+	tests := []struct {
+		name string
+		mask string
+		opts []Option
+		want []string
+	}{
+		{
+			name: "fields",
+			mask: "int32_field,string_field,message_field.int32_field,message_field.message_field.int32_field,message_field.message_field.string_field,map_string_string_field,repeated_message_field.*.int32_field",
+			want: []string{
+				"set int32_field",
+				"cleared string_field",
+				"cleared message_field.int32_field",
+				"set message_field.message_field.int32_field",
+				"set message_field.message_field.string_field",
+				"set map_string_string_field",
+				"set repeated_message_field",
+			},
+		},
+		{
+			name: "absent message",
+			mask: "message_field.message_field.message_field",
+			want: []string{"cleared message_field.message_field.message_field"},
+		},
+		{
+			name: "append",
+			mask: "repeated_int32_field,repeated_message_field",
+			opts: []Option{WithUpdateRepeated(UpdateAppendsRepeated)},
+			want: []string{"set repeated_message_field"},
+		},
+		{
+			name: "condition",
+			mask: "int32_field,bool_field",
+			opts: []Option{WithUpdateCondition(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+				return fd.Name() != "bool_field"
+			})},
+			want: []string{"set int32_field"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			onUpdate := WithOnUpdateField(func(path string, fd protoreflect.FieldDescriptor, cleared bool) {
+				if cleared {
+					got = append(got, "cleared "+path)
+				} else {
+					got = append(got, "set "+path)
+				}
+			})
+			fm, err := Parse[*testpb.Message](tt.mask, append(tt.opts, onUpdate)...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			if err := fm.Update(clone(dst), src); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			slices.Sort(got)
+			slices.Sort(tt.want)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("OnUpdateField: unexpected fields (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddFieldByNumber(t *testing.T) {
+	fm, err := New[*testpb.Message]([]string{"int32_field"})
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	if err := fm.AddFieldByNumber(3, 2); err != nil {
+		t.Fatalf("AddFieldByNumber: unexpected error: %v", err)
+	}
+	if err := fm.AddFieldPathByNumber(11, 11, 3); err != nil {
+		t.Fatalf("AddFieldPathByNumber: unexpected error: %v", err)
+	}
+	if err := fm.AddFieldPathByNumber(211); err != nil {
+		t.Fatalf("AddFieldPathByNumber: unexpected error: %v", err)
+	}
+	want := []string{"int32_field", "message_field.message_field.int32_field", "repeated_message_field", "string_field"}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+	}
+
+	jm, err := New[*testpb.Message]([]string{"stringField"}, WithFieldName(JSONFieldName, false))
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	if err := jm.AddFieldPathByNumber(11, 3); err != nil {
+		t.Fatalf("AddFieldPathByNumber: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"messageField.int32Field", "stringField"}, jm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+	}
+
+	for _, nums := range [][]protoreflect.FieldNumber{
+		{},
+		{9999},
+		{11, 9999},
+		{3, 3},
+		{211, 3},
+		{503, 3},
+	} {
+		if err := fm.AddFieldPathByNumber(nums...); err == nil {
+			t.Errorf("AddFieldPathByNumber(%v): expected error", nums)
+		}
+	}
+}
+
+func TestAlias(t *testing.T) {
+	alias := WithAlias(map[string]string{
+		"number": "int32_field",
+		"nested": "message_field",
+		"inner":  "message_field.message_field",
+	})
+	for _, tt := range []struct {
+		mask string
+		out  []string
+	}{
+		{
+			mask: "number",
+			out:  []string{"number"},
+		},
+		{
+			mask: "int32_field",
+			out:  []string{"number"},
+		},
+		{
+			mask: "nested.string_field,number",
+			out:  []string{"nested.string_field", "number"},
+		},
+		{
+			mask: "nested.message_field.int32_field",
+			out:  []string{"inner.int32_field"},
+		},
+		{
+			mask: "inner.string_field,nested.int32_field",
+			out:  []string{"inner.string_field", "nested.int32_field"},
+		},
+		{
+			mask: "numberx",
+			out:  nil,
+		},
+	} {
+		t.Run(tt.mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, alias)
+			if tt.out == nil {
+				if err == nil {
+					t.Fatalf("Parse: expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			if diff := cmp.Diff(tt.out, fm.Paths()); diff != "" {
+				t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+			}
+			fm, err = New[*testpb.Message](fm.Paths(), alias)
+			if err != nil {
+				t.Fatalf("New: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.out, fm.Paths()); diff != "" {
+				t.Fatalf("Paths: unexpected round-trip diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	fm, err := Parse[*testpb.Message]("number", alias)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if got := fm.Clone(testMsg); got.Int32Field != testMsg.Int32Field || got.StringField != "" {
+		t.Errorf("Clone: unexpected output: %v", got)
+	}
+
+	for name, aliases := range map[string]map[string]string{
+		"shadow":           {"int32_field": "string_field"},
+		"invalid-internal": {"number": "invalid_field"},
+		"empty":            {"": "int32_field"},
+		"wild":             {"*": "int32_field"},
+	} {
+		if _, err := Parse[*testpb.Message]("*", WithAlias(aliases)); err == nil {
+			t.Errorf("Parse: expected error for %s alias", name)
+		}
+	}
+}
+
+func TestAppendReport(t *testing.T) {
+	fm, err := Parse[*testpb.Message]("message_field.int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	for _, tt := range []struct {
+		path  string
+		added []string
+	}{
+		{path: "message_field.string_field", added: []string{"message_field.string_field"}},
+		{path: "message_field.string_field", added: nil},
+		{path: "map_string_message_field.foo.int32_field", added: []string{"map_string_message_field.foo.int32_field"}},
+		{path: "map_string_message_field.*.int32_field", added: []string{"map_string_message_field.*.int32_field"}},
+		{path: "map_string_message_field.bar.int32_field", added: nil},
+		{path: "message_field", added: []string{"message_field"}},
+		{path: "message_field.bool_field", added: nil},
+		{path: "*", added: []string{"*"}},
+		{path: "int32_field", added: nil},
+	} {
+		added, err := fm.AppendReport(tt.path)
+		if err != nil {
+			t.Fatalf("AppendReport(%q): unexpected error: %v", tt.path, err)
+		}
+		if diff := cmp.Diff(tt.added, added); diff != "" {
+			t.Errorf("AppendReport(%q): unexpected diff:\n%s", tt.path, diff)
+		}
+	}
+
+	fm, err = Parse[*testpb.Message]("int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if _, err := fm.AppendReport("invalid_field"); err == nil {
+		t.Error("AppendReport: expected error")
+	}
+	if diff := cmp.Diff([]string{"int32_field"}, fm.Paths()); diff != "" {
+		t.Errorf("Paths: unexpected diff:\n%s", diff)
+	}
+}
+
+func TestValidatePaths(t *testing.T) {
+	fm, err := Parse[*testpb.Message]("*")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if err := fm.ValidatePaths([]string{"*", "int32_field", "message_field.string_field", "map_string_message_field.foo.int32_field"}); err != nil {
+		t.Fatalf("ValidatePaths: unexpected error: %v", err)
+	}
+	invalid := []string{"unknown_field", "message_field.unknown_field", "map_string_message_field.foo.unknown_field"}
+	err = fm.ValidatePaths(append([]string{"*", "int32_field"}, invalid...))
+	if err == nil {
+		t.Fatal("ValidatePaths: expected error")
+	}
+	for _, path := range invalid {
+		if !strings.Contains(err.Error(), strconv.Quote(path)) {
+			t.Errorf("ValidatePaths: error doesn't mention path %q: %v", path, err)
+		}
+	}
+	if got, want := strings.Count(err.Error(), "\n")+1, len(invalid); got != want {
+		t.Errorf("ValidatePaths: got %d errors; want %d: %v", got, want, err)
+	}
+	if diff := cmp.Diff([]string{"*"}, fm.Paths()); diff != "" {
+		t.Errorf("Paths: unexpected diff:\n%s", diff)
+	}
+}
+
+func TestWouldModify(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field:          1,
+		StringField:         "foo",
+		RepeatedStringField: []string{"a", "b"},
+		MessageField:        &testpb.Message{Int32Field: 2},
+		RepeatedMessageField: []*testpb.Message{
+			{Int32Field: 3},
+			{Int32Field: 4, StringField: "bar"},
+		},
+		MapStringStringField: map[string]string{"a": "a", "b": "b"},
+		MapStringMessageField: map[string]*testpb.Message{
+			"a": {Int32Field: 5},
+			"b": {},
+		},
+	}
+	for _, tt := range []struct {
+		mask string
+		opts []Option
+		msg  *testpb.Message
+		want bool
+	}{
+		{mask: "*", msg: msg, want: false},
+		{mask: "*", msg: testMsg, want: false},
+		{mask: "int32_field", msg: msg, want: true},
+		{mask: "int32_field", msg: &testpb.Message{Int32Field: 1}, want: false},
+		{mask: "int32_field,string_field,repeated_string_field,message_field,repeated_message_field,map_string_string_field,map_string_message_field", msg: msg, want: false},
+		{mask: "int32_field,string_field,repeated_string_field,message_field,repeated_message_field,map_string_string_field", msg: msg, want: true},
+		{mask: "message_field.int32_field", msg: &testpb.Message{MessageField: msg.MessageField}, want: false},
+		{mask: "message_field.string_field", msg: &testpb.Message{MessageField: msg.MessageField}, want: true},
+		{mask: "repeated_message_field.*.int32_field", msg: &testpb.Message{RepeatedMessageField: msg.RepeatedMessageField}, want: true},
+		{mask: "repeated_message_field.0", msg: &testpb.Message{RepeatedMessageField: msg.RepeatedMessageField}, want: true},
+		{mask: "repeated_message_field.1", msg: &testpb.Message{RepeatedMessageField: msg.RepeatedMessageField}, want: true},
+		{mask: "repeated_message_field.0,repeated_message_field.1", msg: &testpb.Message{RepeatedMessageField: msg.RepeatedMessageField}, want: false},
+		{mask: "map_string_string_field.a", msg: &testpb.Message{MapStringStringField: msg.MapStringStringField}, want: true},
+		{mask: "map_string_string_field.a,map_string_string_field.b,map_string_string_field.c", msg: &testpb.Message{MapStringStringField: msg.MapStringStringField}, want: false},
+		{mask: "map_string_message_field.*.int32_field", msg: &testpb.Message{MapStringMessageField: msg.MapStringMessageField}, want: false},
+		{mask: "map_string_message_field.b", msg: &testpb.Message{MapStringMessageField: msg.MapStringMessageField}, want: true},
+		{mask: "map_string_message_field", opts: []Option{WithDropEmptyMapValues(true)}, msg: &testpb.Message{MapStringMessageField: msg.MapStringMessageField}, want: true},
+		{mask: "*", opts: []Option{WithMapKeyFilter("map_string_string_field", func(k protoreflect.MapKey) bool { return k.String() != "b" })}, msg: msg, want: true},
+		{mask: "*", opts: []Option{WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool { return fd.Name() != "string_field" })}, msg: msg, want: true},
+		{mask: "*", opts: []Option{WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool { return fd.Name() != "bool_field" })}, msg: msg, want: false},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+		if got := fm.WouldModify(tt.msg); got != tt.want {
+			t.Errorf("WouldModify(%q): got: %v; want: %v", tt.mask, got, tt.want)
+		}
+		masked := clone(tt.msg)
+		fm.Mask(masked)
+		if got, want := !proto.Equal(tt.msg, masked), tt.want; got != want {
+			t.Errorf("Mask(%q): got modified: %v; want: %v", tt.mask, got, want)
+		}
+	}
+}
+
+func TestCloneChecked(t *testing.T) {
+	for _, tt := range []struct {
+		mask      string
+		msg       *testpb.Message
+		populated bool
+	}{
+		{"int32_field", testMsg, true},
+		{"int32_field", &testpb.Message{StringField: "foo"}, false},
+		{"*", &testpb.Message{}, false},
+		{"message_field.int32_field", &testpb.Message{MessageField: &testpb.Message{StringField: "foo"}}, true},
+		{"map_string_string_field.foo", &testpb.Message{MapStringStringField: map[string]string{"bar": "bar"}}, false},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+		out, populated := fm.CloneChecked(tt.msg)
+		if populated != tt.populated {
+			t.Errorf("CloneChecked(%q): got populated: %v; want: %v", tt.mask, populated, tt.populated)
+		}
+		if diff := protoDiff(fm.Clone(tt.msg), out); diff != "" {
+			t.Errorf("CloneChecked(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+	}
+}
+
+func TestCloneInto(t *testing.T) {
+	for _, mask := range []string{
+		"*",
+		"string_field,bytes_field,repeated_bytes_field,map_string_bytes_field,message_field.bytes_field",
+	} {
+		t.Run(mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](mask)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := &testpb.Message{}
+			first := &testpb.Message{
+				Int32Field:          1,
+				StringField:         "first",
+				BytesField:          []byte("first"),
+				RepeatedBytesField:  [][]byte{[]byte("first")},
+				MapStringBytesField: map[string][]byte{"first": []byte("first")},
+				MessageField:        &testpb.Message{Int32Field: 2, BytesField: []byte("first")},
+			}
+			fm.CloneInto(dst, first)
+			if diff := protoDiff(fm.Clone(first), dst); diff != "" {
+				t.Fatalf("CloneInto: unexpected diff:\n%s", diff)
+			}
+			second := &testpb.Message{
+				StringField: "second",
+				BytesField:  []byte("second"),
+			}
+			fm.CloneInto(dst, second)
+			if diff := protoDiff(fm.Clone(second), dst); diff != "" {
+				t.Fatalf("CloneInto: unexpected diff:\n%s", diff)
+			}
+			second.BytesField[0] = 'X'
+			if got, want := string(dst.BytesField), "second"; got != want {
+				t.Errorf("CloneInto: bytes field aliases source: got: %q; want: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffAll(t *testing.T) {
+	base := &testpb.Message{
+		Int32Field:           1,
+		StringField:          "base",
+		RepeatedInt32Field:   []int32{1, 2},
+		MessageField:         &testpb.Message{Int32Field: 2, StringField: "base"},
+		MapStringStringField: map[string]string{"a": "a", "b": "b"},
+		MapStringMessageField: map[string]*testpb.Message{
+			"a": {Int32Field: 1, StringField: "a"},
+			"b": {Int32Field: 2},
+		},
+	}
+	variants := []*testpb.Message{
+		func() *testpb.Message {
+			m := clone(base)
+			m.Int32Field = 10
+			m.MessageField.StringField = "variant"
+			m.MapStringStringField["a"] = "changed"
+			delete(m.MapStringMessageField, "b")
+			return m
+		}(),
+		func() *testpb.Message {
+			m := clone(base)
+			m.RepeatedInt32Field = append(m.RepeatedInt32Field, 3)
+			m.MapStringStringField["c"] = "c"
+			m.MapStringMessageField["a"].Int32Field = 10
+			m.BoolField = true
+			return m
+		}(),
+		clone(base),
+	}
+	fm, err := DiffAll(base, variants)
+	if err != nil {
+		t.Fatalf("DiffAll: unexpected error: %v", err)
+	}
+	want := []string{
+		"bool_field",
+		"int32_field",
+		"map_string_message_field.a.int32_field",
+		"map_string_message_field.b",
+		"map_string_string_field.a",
+		"map_string_string_field.c",
+		"message_field.string_field",
+		"repeated_int32_field",
+	}
+	if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+	}
+
+	// Applying each variant to the base with the mask results in the variant.
+	for i, variant := range variants {
+		dst := clone(base)
+		if err := fm.Update(dst, variant); err != nil {
+			t.Fatalf("Update: unexpected error: %v", err)
+		}
+		if diff := protoDiff(variant, dst); diff != "" {
+			t.Errorf("Update: unexpected diff for variant %d:\n%s", i, diff)
+		}
+	}
+
+	fm, err = DiffAll(base, []*testpb.Message{clone(base)})
+	if err != nil {
+		t.Fatalf("DiffAll: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"*"}, fm.Paths()); diff != "" {
+		t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffPresence(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *testpb.Proto2Message
+		mode DiffPresence
+		want []string
+	}{
+		{
+			name: "present zero",
+			a:    &testpb.Proto2Message{},
+			b:    &testpb.Proto2Message{Int32Field: proto.Int32(0), StringField: proto.String("")},
+			want: []string{"int32_field", "string_field"},
+		},
+		{
+			name: "present zero ignored",
+			a:    &testpb.Proto2Message{},
+			b:    &testpb.Proto2Message{Int32Field: proto.Int32(0), StringField: proto.String("")},
+			mode: DiffIgnoresPresence,
+			want: []string{"*"},
+		},
+		{
+			name: "explicit default ignored",
+			a:    &testpb.Proto2Message{Int64Field: proto.Int64(64)},
+			b:    &testpb.Proto2Message{},
+			mode: DiffIgnoresPresence,
+			want: []string{"*"},
+		},
+		{
+			name: "zero differs from default",
+			a:    &testpb.Proto2Message{Int64Field: proto.Int64(0)},
+			b:    &testpb.Proto2Message{},
+			mode: DiffIgnoresPresence,
+			want: []string{"int64_field"},
+		},
+		{
+			name: "empty message",
+			a:    &testpb.Proto2Message{MessageField: &testpb.Proto2Message{}},
+			b:    &testpb.Proto2Message{},
+			want: []string{"message_field"},
+		},
+		{
+			name: "empty message ignored",
+			a:    &testpb.Proto2Message{MessageField: &testpb.Proto2Message{}},
+			b:    &testpb.Proto2Message{},
+			mode: DiffIgnoresPresence,
+			want: []string{"*"},
+		},
+		{
+			name: "nested ignored",
+			a:    &testpb.Proto2Message{MessageField: &testpb.Proto2Message{BoolField: proto.Bool(false)}},
+			b:    &testpb.Proto2Message{MessageField: &testpb.Proto2Message{StringField: proto.String("a")}},
+			mode: DiffIgnoresPresence,
+			want: []string{"message_field.string_field"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Diff(tt.a, tt.b, WithDiffPresence(tt.mode))
+			if err != nil {
+				t.Fatalf("Diff: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, fm.Paths()); diff != "" {
+				t.Fatalf("Paths: unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	// Proto3 scalar fields have implicit presence, so only the message field depends on the mode.
+	a := &testpb.Message{Int32Field: 1, MessageField: &testpb.Message{}}
+	b := &testpb.Message{StringField: "b"}
+	for mode, want := range map[DiffPresence][]string{
+		DiffComparesPresence: {"int32_field", "message_field", "string_field"},
+		DiffIgnoresPresence:  {"int32_field", "string_field"},
+	} {
+		fm, err := Diff(a, b, WithDiffPresence(mode))
+		if err != nil {
+			t.Fatalf("Diff: unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(want, fm.Paths()); diff != "" {
+			t.Errorf("Paths: unexpected diff for mode %d (-want +got):\n%s", mode, diff)
+		}
+	}
+}
+
+func TestSplitByTopLevel(t *testing.T) {
+	fm, err := Parse[*testpb.Message]("int32_field,message_field.int32_field,message_field.string_field,map_string_message_field.foo.int32_field,repeated_int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	want := map[string][]string{
+		"int32_field":              {"int32_field"},
+		"message_field":            {"message_field.int32_field", "message_field.string_field"},
+		"map_string_message_field": {"map_string_message_field.foo.int32_field"},
+		"repeated_int32_field":     {"repeated_int32_field"},
+	}
+	got := make(map[string][]string)
+	for key, sub := range fm.SplitByTopLevel() {
+		got[key] = sub.Paths()
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("SplitByTopLevel: unexpected diff (-want +got):\n%s", diff)
+	}
+
+	// The sub-masks are independent of the original mask.
+	split := fm.SplitByTopLevel()
+	if err := split["message_field"].Append("bool_field"); err != nil {
+		t.Fatalf("Append: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want["message_field"], fm.SplitByTopLevel()["message_field"].Paths()); diff != "" {
+		t.Fatalf("SplitByTopLevel: unexpected diff after append (-want +got):\n%s", diff)
+	}
+
+	fm, err = Parse[*testpb.Message]("*", WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool {
+		return fd.Number() < 10
+	}))
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	got = make(map[string][]string)
+	for key, sub := range fm.SplitByTopLevel() {
+		got[key] = sub.Paths()
+	}
+	want = make(map[string][]string)
+	fds := testMsg.ProtoReflect().Descriptor().Fields()
+	for i, n := 0, fds.Len(); i < n; i++ {
+		if fd := fds.Get(i); fd.Number() < 10 {
+			want[string(fd.Name())] = []string{string(fd.Name())}
+		}
+	}
+	if len(want) == 0 {
+		t.Fatal("no fields to split")
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("SplitByTopLevel: unexpected diff for complete mask (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		want  []string
+		optsA []Option
+		optsB []Option
+		err   string
+	}{
+		{
+			name: "disjoint",
+			a:    "int32_field,message_field.int32_field",
+			b:    "string_field,message_field.string_field",
+			want: []string{"int32_field", "message_field.int32_field", "message_field.string_field", "string_field"},
+		},
+		{
+			name: "overlapping",
+			a:    "message_field",
+			b:    "message_field.int32_field,map_string_message_field.foo",
+			want: []string{"map_string_message_field.foo", "message_field"},
+		},
+		{
+			name: "complete",
+			a:    "int32_field",
+			b:    "*",
+			want: []string{"*"},
+		},
+		{
+			name:  "field names",
+			a:     "int32_field",
+			b:     "stringField",
+			optsB: []Option{WithFieldName(JSONFieldName, false)},
+			err:   "incompatible mask settings: mismatched field names",
+		},
+		{
+			name:  "path separator",
+			a:     "int32_field",
+			b:     "string_field",
+			optsA: []Option{WithPathSeparator('/')},
+			err:   "incompatible mask settings: mismatched path separator",
+		},
+		{
+			name:  "ignored functions",
+			a:     "int32_field",
+			b:     "string_field",
+			optsB: []Option{WithOnClear(func(string, protoreflect.FieldDescriptor) {})},
+			want:  []string{"int32_field", "string_field"},
+		},
+		{
+			name:  "max map keys",
+			a:     "map_string_string_field.a",
+			b:     "map_string_string_field.b",
+			optsA: []Option{WithMaxMapKeys(1)},
+			optsB: []Option{WithMaxMapKeys(1)},
+			want:  []string{"map_string_string_field.a", "map_string_string_field.b"},
+		},
+		{
+			name:  "field max depths",
+			a:     "message_field.int32_field",
+			b:     "int32_field",
+			optsA: []Option{WithFieldMaxDepth("message_field", 2)},
+			err:   "incompatible mask settings: mismatched field max depths",
+		},
+		{
+			name:  "map value updates",
+			a:     "map_int32_message_field",
+			b:     "map_int32_message_field",
+			optsA: []Option{WithMapValueUpdate("map_int32_message_field", ReplaceMapValues, "1")},
+			optsB: []Option{WithMapValueUpdate("map_int32_message_field", ReplaceMapValues, "2")},
+			err:   "incompatible mask settings: mismatched map value updates",
+		},
+		{
+			name: "conflicting slices",
+			a:    "repeated_int32_field[0:2]",
+			b:    "repeated_int32_field[-3:]",
+			err:  `incompatible path "repeated_int32_field[-3:]": conflicting list slices: [0:2] and [-3:]`,
+		},
+		{
+			name: "slice and index",
+			a:    "repeated_message_field[0:2]",
+			b:    "repeated_message_field.1.int32_field",
+			err:  `incompatible path "repeated_message_field.1.int32_field": invalid list path: "1.int32_field": can't be combined with list slice [0:2]`,
+		},
+		{
+			name:  "rejected overrides",
+			a:     "repeated_message_field",
+			b:     "repeated_message_field.*.int32_field",
+			optsA: []Option{WithRejectOverriddenPaths(true)},
+			optsB: []Option{WithRejectOverriddenPaths(true)},
+			want:  []string{"repeated_message_field"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Parse[*testpb.Message](tt.a, tt.optsA...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			b, err := Parse[*testpb.Message](tt.b, tt.optsB...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			before := a.Paths()
+			got, err := a.Union(b)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("Union: unexpected error: got: %v; want: %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Union: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got.Paths()); diff != "" {
+				t.Fatalf("Union: unexpected paths (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(before, a.Paths()); diff != "" {
+				t.Fatalf("Union: modified receiver (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReflect(t *testing.T) {
+	desc := (&testpb.Message{}).ProtoReflect().Descriptor()
+	fm, err := Parse[proto.Message]("int32_field,message_field.string_field", WithMessageDescriptor(desc))
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+
+	msg := clone(testMsg)
+	if err := fm.MaskReflect(msg.ProtoReflect()); err != nil {
+		t.Fatalf("MaskReflect: unexpected error: %v", err)
+	}
+	want := &testpb.Message{
+		Int32Field:   testMsg.Int32Field,
+		MessageField: &testpb.Message{StringField: testMsg.MessageField.StringField},
+	}
+	if diff := protoDiff(want, msg); diff != "" {
+		t.Fatalf("MaskReflect: unexpected diff:\n%s", diff)
+	}
+
+	dyn := dynamicpb.NewMessage(desc)
+	if err := fm.UpdateReflect(dyn, testMsg.ProtoReflect()); err != nil {
+		t.Fatalf("UpdateReflect: unexpected error: %v", err)
+	}
+	if diff := protoDiff[proto.Message](want, dyn); diff != "" {
+		t.Fatalf("UpdateReflect: unexpected diff:\n%s", diff)
+	}
+	if err := fm.UpdateReflect(dyn, nil); err != nil {
+		t.Fatalf("UpdateReflect: unexpected error for nil src: %v", err)
+	}
+	if diff := protoDiff[proto.Message](&testpb.Message{MessageField: &testpb.Message{}}, dyn); diff != "" {
+		t.Fatalf("UpdateReflect: unexpected diff for nil src:\n%s", diff)
+	}
+
+	other := (&testpb.Proto2Message{}).ProtoReflect()
+	if err := fm.MaskReflect(other); err == nil {
+		t.Error("MaskReflect: expected error for mismatched type")
+	}
+	if err := fm.MaskReflect(nil); err == nil {
+		t.Error("MaskReflect: expected error for nil message")
+	}
+	if err := fm.UpdateReflect(other, other); err == nil {
+		t.Error("UpdateReflect: expected error for mismatched type")
+	}
+	if err := fm.UpdateReflect(dyn, other); err == nil {
+		t.Error("UpdateReflect: expected error for mismatched src type")
+	}
+	if err := fm.UpdateReflect(nil, dyn); err == nil {
+		t.Error("UpdateReflect: expected error for nil dst")
+	}
+}
+
+func TestCoversPresent(t *testing.T) {
+	fm, err := Parse[*testpb.Message](joinMasks(
+		"int32_field",
+		"string_field",
+		"message_field.int32_field",
+		"repeated_string_field",
+		"repeated_message_field.*.int32_field",
+		"repeated_message_field.1.string_field",
+		"map_string_string_field.foo",
+		"map_int32_message_field.*.string_field",
+		"map_string_message_field",
+	))
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	msg := &testpb.Message{
+		Int32Field:          1,
+		MessageField:        simpleMsg(2, "nested"),
+		RepeatedStringField: []string{"a", "b"},
+		RepeatedMessageField: []*testpb.Message{
+			simpleMsg(0, "repeated(0)"),
+			simpleMsg(1, "repeated(1)"),
+		},
+		MapStringStringField: map[string]string{
+			"foo": "string(foo)",
+			"bar": "string(bar)",
+		},
+		MapInt32MessageField: map[int32]*testpb.Message{
+			1: simpleMsg(1, "int32(1)"),
+		},
+		MapStringMessageField: map[string]*testpb.Message{
+			"foo": simpleMsg(1, "string(foo)"),
+		},
+		BoolField: true,
+	}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "int32_field", want: true},
+		{path: "string_field", want: false},    // absent
+		{path: "bool_field", want: false},      // masked out
+		{path: "unknown_field", want: false},   // invalid
+		{path: "int32_field.foo", want: false}, // invalid
+		{path: "message_field", want: true},
+		{path: "message_field.int32_field", want: true},
+		{path: "message_field.string_field", want: false},
+		{path: "message_field.message_field", want: false},
+		{path: "repeated_string_field", want: true},
+		{path: "repeated_string_field.1", want: true},
+		{path: "repeated_string_field.2", want: false},
+		{path: "repeated_string_field.x", want: false},
+		{path: "repeated_message_field.0.int32_field", want: false}, // zero value
+		{path: "repeated_message_field.1.int32_field", want: true},
+		{path: "repeated_message_field.0.string_field", want: false},
+		{path: "repeated_message_field.1.string_field", want: true},
+		{path: "repeated_message_field.2.int32_field", want: false},
+		{path: "map_string_string_field.foo", want: true},
+		{path: "map_string_string_field.bar", want: false},
+		{path: "map_string_string_field.qux", want: false},
+		{path: "map_int32_message_field.1", want: true},
+		{path: "map_int32_message_field.1.string_field", want: true},
+		{path: "map_int32_message_field.1.int32_field", want: false},
+		{path: "map_int32_message_field.2.string_field", want: false},
+		{path: "map_int32_message_field.x", want: false},
+		{path: "map_string_message_field.foo.int32_field", want: true},
+		{path: "map_string_message_field.foo.message_field.string_field", want: true},
+		{path: "map_string_message_field.foo.bool_field", want: false},
+		{path: "map_string_message_field.bar", want: false},
+		{path: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := fm.CoversPresent(msg, tt.path); got != tt.want {
+			t.Errorf("CoversPresent(%q): got: %v; want: %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestFromStruct(t *testing.T) {
+	list := func(vals ...*structpb.Value) *structpb.Value {
+		return structpb.NewListValue(&structpb.ListValue{Values: vals})
+	}
+	tests := []struct {
+		name  string
+		value *structpb.Value
+		opts  []Option
+		paths []string
+		err   bool
+	}{
+		{
+			name:  "string",
+			value: structpb.NewStringValue("string_field,message_field.int32_field"),
+			paths: []string{"message_field.int32_field", "string_field"},
+		},
+		{
+			name:  "list",
+			value: list(structpb.NewStringValue("string_field"), structpb.NewStringValue("message_field.int32_field")),
+			paths: []string{"message_field.int32_field", "string_field"},
+		},
+		{
+			name:  "empty-list",
+			value: list(),
+			paths: []string{"*"},
+		},
+		{
+			name:  "options",
+			value: structpb.NewStringValue("stringField"),
+			opts:  []Option{WithFieldName(JSONFieldName, true)},
+			paths: []string{"stringField"},
+		},
+		{name: "invalid-string", value: structpb.NewStringValue("unknown_field"), err: true},
+		{name: "invalid-list-path", value: list(structpb.NewStringValue("unknown_field")), err: true},
+		{name: "number-element", value: list(structpb.NewStringValue("string_field"), structpb.NewNumberValue(1)), err: true},
+		{name: "list-element", value: list(list()), err: true},
+		{name: "number", value: structpb.NewNumberValue(1), err: true},
+		{name: "bool", value: structpb.NewBoolValue(true), err: true},
+		{name: "null", value: structpb.NewNullValue(), err: true},
+		{name: "nil", value: nil, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := FromStruct[*testpb.Message](tt.value, tt.opts...)
+			if tt.err {
+				if err == nil {
+					t.Fatal("FromStruct: expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStruct: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.paths, fm.Paths()); diff != "" {
+				t.Errorf("Paths: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFromMessageField(t *testing.T) {
+	req := &testpb.UpdateRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"string_field", "message_field.int32_field"}},
+		Nested: &testpb.UpdateRequest{
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"int32_field"}},
+		},
+	}
+	tests := []struct {
+		name  string
+		req   proto.Message
+		path  string
+		opts  []Option
+		paths []string
+		err   bool
+	}{
+		{
+			name:  "field",
+			req:   req,
+			path:  "update_mask",
+			paths: []string{"message_field.int32_field", "string_field"},
+		},
+		{
+			name:  "nested",
+			req:   req,
+			path:  "nested.updateMask",
+			paths: []string{"int32_field"},
+		},
+		{
+			name:  "unset",
+			req:   req,
+			path:  "nested.nested.update_mask",
+			paths: []string{"*"},
+		},
+		{
+			name: "options",
+			req: &testpb.UpdateRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"stringField"}},
+			},
+			path:  "update_mask",
+			opts:  []Option{WithFieldName(JSONFieldName, true)},
+			paths: []string{"stringField"},
+		},
+		{name: "invalid-mask-path", req: &testpb.UpdateRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown_field"}}}, path: "update_mask", err: true},
+		{name: "not-a-mask", req: req, path: "message", err: true},
+		{name: "scalar", req: req, path: "message.string_field", err: true},
+		{name: "unknown", req: req, path: "unknown_field", err: true},
+		{name: "empty", req: req, path: "", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := FromMessageField[*testpb.Message](tt.req, tt.path, tt.opts...)
+			if tt.err {
+				if err == nil {
+					t.Fatal("FromMessageField: expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromMessageField: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.paths, fm.Paths()); diff != "" {
+				t.Errorf("Paths: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateWith(t *testing.T) {
+	dst := &testpb.Message{
+		RepeatedStringField:  []string{"a"},
+		RepeatedMessageField: []*testpb.Message{{Int32Field: 1}},
+		MessageField:         &testpb.Message{Int32Field: 2},
+		MapStringStringField: map[string]string{"foo": "foo"},
+	}
+	src := &testpb.Message{
+		RepeatedStringField:  []string{"b"},
+		RepeatedMessageField: []*testpb.Message{{Int32Field: 3}},
+	}
+	fm, err := Parse[*testpb.Message]("repeated_string_field,repeated_message_field,message_field.int32_field,map_string_string_field")
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts []UpdateOption
+		want *testpb.Message
+	}{
+		{
+			name: "default",
+			want: &testpb.Message{
+				RepeatedStringField:  []string{"b"},
+				RepeatedMessageField: []*testpb.Message{{Int32Field: 3}},
+				MessageField:         &testpb.Message{},
+				MapStringStringField: map[string]string{},
+			},
+		},
+		{
+			name: "overrides",
+			opts: []UpdateOption{
+				WithUpdateRepeated(UpdateAppendsRepeated),
+				WithUpdatePruneEmptyMessages(true),
+				WithUpdateClearsEmptyMaps(true),
+			},
+			want: &testpb.Message{
+				RepeatedStringField:  []string{"a", "b"},
+				RepeatedMessageField: []*testpb.Message{{Int32Field: 1}, {Int32Field: 3}},
+			},
+		},
+		{
+			name: "last-wins",
+			opts: []UpdateOption{
+				WithUpdateRepeated(UpdateAppendsRepeated),
+				WithUpdateRepeated(UpdateReplacesRepeated),
+			},
+			want: &testpb.Message{
+				RepeatedStringField:  []string{"b"},
+				RepeatedMessageField: []*testpb.Message{{Int32Field: 3}},
+				MessageField:         &testpb.Message{},
+				MapStringStringField: map[string]string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clone(dst)
+			if err := fm.UpdateWith(got, src, tt.opts...); err != nil {
+				t.Fatalf("UpdateWith: unexpected error: %v", err)
+			}
+			if diff := protoDiff(tt.want, got); diff != "" {
+				t.Errorf("UpdateWith: unexpected diff:\n%s", diff)
+			}
+			// The mask isn't changed by the overrides.
+			got = clone(dst)
+			if err := fm.Update(got, src); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if diff := protoDiff(tests[0].want, got); diff != "" {
+				t.Errorf("Update: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+
+	// Unknowns are overridden too.
+	unknown := protowire.AppendTag(nil, 1000, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	srcUnknown := &testpb.Message{}
+	srcUnknown.ProtoReflect().SetUnknown(unknown)
+	got := &testpb.Message{}
+	if err := fm.UpdateWith(got, srcUnknown, WithUpdateUnknowns(UpdateReplacesUnknowns)); err != nil {
+		t.Fatalf("UpdateWith: unexpected error: %v", err)
+	}
+	if raw := got.ProtoReflect().GetUnknown(); string(raw) != string(unknown) {
+		t.Errorf("UpdateWith: unexpected unknowns: got: %x; want: %x", raw, unknown)
+	}
+	if err := fm.UpdateWith(nil, src); err != errNilDestination {
+		t.Errorf("UpdateWith(nil): got: %v; want: %v", err, errNilDestination)
+	}
+}
+
+func TestRejectOverriddenPaths(t *testing.T) {
+	reject := WithRejectOverriddenPaths(true)
+	for _, tt := range []struct {
+		mask string
+		err  []string // paths reported by the error, if any
+	}{
+		{
+			mask: "repeated_message_field.*.int32_field,repeated_message_field",
+			err:  []string{"repeated_message_field.*.int32_field", "repeated_message_field"},
+		},
+		{
+			mask: "repeated_message_field,repeated_message_field.*.int32_field",
+			err:  []string{"repeated_message_field", "repeated_message_field.*.int32_field"},
+		},
+		{
+			mask: "repeated_message_field.*,repeated_message_field.0.int32_field",
+			err:  []string{"repeated_message_field", "repeated_message_field.0.int32_field"},
+		},
+		{
+			mask: "repeated_int32_field[1:],repeated_int32_field",
+			err:  []string{"repeated_int32_field[1:]", "repeated_int32_field"},
+		},
+		{
+			mask: "map_string_message_field.a.int32_field,map_string_message_field.*",
+			err:  []string{"map_string_message_field.a.int32_field", "map_string_message_field.*"},
+		},
+		{
+			mask: "map_string_message_field.`a.b`.repeated_message_field.*.int32_field,map_string_message_field.`a.b`.repeated_message_field",
+			err:  []string{"map_string_message_field.`a.b`.repeated_message_field.*.int32_field", "map_string_message_field.`a.b`.repeated_message_field"},
+		},
+		{mask: "repeated_message_field.*.int32_field,repeated_message_field.*.string_field"},
+		{mask: "map_string_message_field.a,map_string_message_field.b.int32_field"},
+		{mask: "message_field.int32_field,message_field"},
+	} {
+		_, err := Parse[*testpb.Message](tt.mask, reject)
+		if (err != nil) != (tt.err != nil) {
+			t.Fatalf("Parse(%q): unexpected error: %v", tt.mask, err)
+		}
+		for _, path := range tt.err {
+			if !strings.Contains(err.Error(), strconv.Quote(path)) {
+				t.Errorf("Parse(%q): error doesn't report path %q: %v", tt.mask, path, err)
+			}
+		}
+		if _, err := New[*testpb.Message](strings.Split(tt.mask, ","), reject); (err != nil) != (tt.err != nil) {
+			t.Fatalf("New(%q): unexpected error: %v", tt.mask, err)
+		}
+		if _, err := Parse[*testpb.Message](tt.mask); err != nil {
+			t.Fatalf("Parse(%q) without rejection: unexpected error: %v", tt.mask, err)
+		}
+	}
+
+	fm, err := New[*testpb.Message]([]string{"repeated_message_field.*.int32_field"}, reject)
+	if err != nil {
+		t.Fatalf("Failed to create mask: %v", err)
+	}
+	if err := fm.Append("repeated_message_field"); err == nil {
+		t.Fatal("Append: expected error")
+	}
+}
+
+func TestMaskWire(t *testing.T) {
+	unknown := protowire.AppendTag(nil, 9999, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	msg := clone(testMsg)
+	msg.ProtoReflect().SetUnknown(unknown)
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+	for _, tt := range []struct {
+		mask string
+		opts []Option
+	}{
+		{mask: "*"},
+		{mask: "*", opts: []Option{WithMaskUnknowns(MaskRetainsUnknowns)}},
+		{mask: "int32_field,message_field.string_field,repeated_string_field,map_string_message_field.foo"},
+		{mask: "int32_field", opts: []Option{WithMaskUnknowns(MaskRetainsUnknowns)}},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+		out, err := fm.MaskWire(data)
+		if err != nil {
+			t.Fatalf("MaskWire(%q): unexpected error: %v", tt.mask, err)
+		}
+		got := &testpb.Message{}
+		if err := proto.Unmarshal(out, got); err != nil {
+			t.Fatalf("MaskWire(%q): failed to unmarshal output: %v", tt.mask, err)
+		}
+		want := clone(msg)
+		fm.Mask(want)
+		if diff := protoDiff(want, got); diff != "" {
+			t.Errorf("MaskWire(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+	}
+
+	fm, err := Parse[*testpb.Message]("int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if _, err := fm.MaskWire(data[:len(data)-1]); err == nil {
+		t.Error("MaskWire: expected error for truncated data")
+	}
+}
+
+func TestSubsetOf(t *testing.T) {
+	parent, err := Parse[*testpb.Message]("int32_field,message_field,map_string_message_field.foo.int32_field,repeated_message_field.*.string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse parent mask: %v", err)
+	}
+	subset := WithSubsetOf(parent)
+	for _, tt := range []struct {
+		mask string
+		bad  string // path that isn't covered, if any
+	}{
+		{mask: "int32_field"},
+		{mask: "message_field.string_field,message_field.message_field"},
+		{mask: "map_string_message_field.foo.int32_field,repeated_message_field.2.string_field"},
+		{mask: "repeated_message_field[1:].string_field"},
+		{mask: "repeated_message_field.*.string_field,map_string_message_field.foo."},
+		{mask: "int32_field,string_field", bad: "string_field"},
+		{mask: "repeated_message_field.2.int32_field", bad: "repeated_message_field.2.int32_field"},
+		{mask: "map_string_message_field.bar.", bad: "map_string_message_field.bar."},
+		{mask: "map_string_message_field.bar.int32_field", bad: "map_string_message_field.bar.int32_field"},
+		{mask: "map_string_message_field.*.int32_field", bad: "map_string_message_field.*.int32_field"},
+		{mask: "repeated_message_field.*", bad: "repeated_message_field.*"},
+		{mask: "*", bad: "*"},
+	} {
+		_, err := Parse[*testpb.Message](tt.mask, subset)
+		if tt.bad == "" {
+			if err != nil {
+				t.Errorf("Parse(%q): unexpected error: %v", tt.mask, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), strconv.Quote(tt.bad)) {
+			t.Errorf("Parse(%q): got error %v; want error for path %q", tt.mask, err, tt.bad)
+		}
+	}
+
+	if _, err := New[*testpb.Message](nil, subset); err == nil {
+		t.Error("New: expected error for a mask that covers the whole message")
+	}
+	fm, err := New[*testpb.Message]([]string{"int32_field"}, subset)
+	if err != nil {
+		t.Fatalf("New: unexpected error: %v", err)
+	}
+	if err := fm.Append("bool_field"); err == nil {
+		t.Error("Append: expected error for a path that isn't covered")
+	}
+	if err := fm.AddFieldByNumber(2); err == nil {
+		t.Error("AddFieldByNumber: expected error for a field that isn't covered")
+	}
+	if err := fm.AddFieldPathByNumber(111, 3); err == nil {
+		t.Error("AddFieldPathByNumber: expected error for a path that isn't covered")
+	}
+	if err := fm.Attach("message_oneof_field", fm); err == nil {
+		t.Error("Attach: expected error for a path that isn't covered")
+	}
+	if err := Graft(fm, "message_oneof_field", fm); err == nil {
+		t.Error("Graft: expected error for a path that isn't covered")
+	}
+	other, err := Parse[*testpb.Message]("bool_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if _, err := fm.Union(other); err == nil {
+		t.Error("Union: expected error for a path that isn't covered")
+	}
+	union, err := fm.Union(fm)
+	if err != nil {
+		t.Fatalf("Union: unexpected error: %v", err)
+	}
+	if err := union.Append("bool_field"); err == nil {
+		t.Error("Append: expected error for a path that isn't covered by the union's parent")
+	}
+	if diff := cmp.Diff([]string{"int32_field"}, fm.Paths()); diff != "" {
+		t.Errorf("unexpected paths after rejected changes (-want +got):\n%s", diff)
+	}
+	// Checking paths against a shared parent doesn't write to it.
+	parent, err = Parse[*testpb.Message]("message_field.message_field", WithFieldMaxDepth("message_field", 2))
+	if err != nil {
+		t.Fatalf("Failed to parse parent mask: %v", err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := Parse[*testpb.Message]("message_field.message_field.int32_field", WithSubsetOf(parent)); err != nil {
+				t.Errorf("Parse: unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := Parse[*testpb.Message]("int32Field", subset, WithFieldName(JSONFieldName, false)); err == nil {
+		t.Error("Parse: expected error for incompatible field names")
+	}
+	whole, err := New[*testpb.Message](nil)
+	if err != nil {
+		t.Fatalf("Failed to create mask: %v", err)
+	}
+	if _, err := New[*testpb.Message](nil, WithSubsetOf(whole)); err != nil {
+		t.Errorf("New: unexpected error with a complete parent mask: %v", err)
+	}
 }
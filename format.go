@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"fmt"
+	"strings"
+
+	"bursavich.dev/fieldmask/internal/quote"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// FormatOptions specifies how to format a mask for display.
+type FormatOptions struct {
+	// Separator is placed between paths. If empty, "," is used.
+	// It's ignored if Indent is set.
+	Separator string
+	// Indent, if non-empty, formats the paths as a tree with one segment per line
+	// and each nested segment indented under its parent.
+	Indent string
+	// Quote is the character used to quote segments that require quoting.
+	// If zero, '`' is used.
+	Quote byte
+	// GroupByField places paths with the same top-level field on the same line,
+	// with each group on its own line. It's ignored if Indent is set.
+	GroupByField bool
+}
+
+// Format returns the paths of the mask formatted for display.
+// The output is not necessarily parsable.
+func (fm *FieldMask[T]) Format(opts FormatOptions) string {
+	if opts.Separator == "" {
+		opts.Separator = ","
+	}
+	if opts.Quote == 0 {
+		opts.Quote = '`'
+	}
+	var paths [][]string
+	for _, path := range fm.Paths() {
+		paths = append(paths, fm.formatSegments(path, opts.Quote))
+	}
+	var b strings.Builder
+	switch {
+	case opts.Indent != "":
+		var prev []string
+		for _, segs := range paths {
+			i := 0
+			for i < len(prev) && i < len(segs) && prev[i] == segs[i] {
+				i++
+			}
+			for ; i < len(segs); i++ {
+				if b.Len() > 0 {
+					b.WriteByte('\n')
+				}
+				b.WriteString(strings.Repeat(opts.Indent, i))
+				b.WriteString(segs[i])
+			}
+			prev = segs
+		}
+	case opts.GroupByField:
+		for i, segs := range paths {
+			if i > 0 {
+				if paths[i-1][0] == segs[0] {
+					b.WriteString(opts.Separator)
+				} else {
+					b.WriteByte('\n')
+				}
+			}
+			b.WriteString(strings.Join(segs, string(fm.pathSep)))
+		}
+	default:
+		for i, segs := range paths {
+			if i > 0 {
+				b.WriteString(opts.Separator)
+			}
+			b.WriteString(strings.Join(segs, string(fm.pathSep)))
+		}
+	}
+	return b.String()
+}
+
+// PathPairs returns the text and JSON forms of each path of the mask, in that order,
+// such as for documentation that shows both conventions. Segments that aren't field names,
+// such as map keys, list indexes and slices, wildcards, and field numbers, are the same in both.
+// Unlike Paths, the paths aren't aliased.
+func (fm *FieldMask[T]) PathPairs() [][2]string {
+	paths := fm.msg.paths()
+	if len(paths) == 0 {
+		return [][2]string{{"*", "*"}}
+	}
+	pairs := make([][2]string, len(paths))
+	for i, path := range paths {
+		text, json, err := fm.pathForms(path)
+		if err != nil {
+			panic(fmt.Sprintf("fieldmask: internal error: failed to resolve path: %q: %v", path, err))
+		}
+		pairs[i] = [2]string{text, json}
+	}
+	return pairs
+}
+
+// pathForms returns the text and JSON forms of the path from the root.
+func (s *settings) pathForms(path string) (text, json string, err error) {
+	join := func(path *string, segment string) {
+		if *path == "" {
+			*path = segment
+		} else {
+			*path = joinFieldPath(*path, segment, s.pathSep)
+		}
+	}
+	both := func(segment string) {
+		join(&text, segment)
+		join(&json, segment)
+	}
+	desc := s.rootDesc
+	for path != "" {
+		if structFieldName(desc) != "" || path == "*" {
+			both(path) // The keys of a dynamic structure aren't field names.
+			break
+		}
+		name, subpath, err := nextSegment(path, s.pathSep)
+		if err != nil {
+			return "", "", err
+		}
+		name, subpath, _ = splitListSlice(name, subpath, s.pathSep)
+		if typeName, ok := anyTypeName(name); ok && desc.FullName() == anyFullName {
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(typeName)
+			if err != nil {
+				return "", "", fmt.Errorf("unknown %v packed type: %q", desc.FullName(), typeName)
+			}
+			both(name)
+			desc, path = mt.Descriptor(), subpath
+			continue
+		}
+		_, fd, ok := s.lookupMessageField(desc, desc.Fields(), name)
+		if !ok {
+			return "", "", s.unknownFieldError(desc, name)
+		}
+		if _, ok := parseFieldNumber(name); ok || fd.IsExtension() {
+			both(name)
+		} else {
+			join(&text, fd.TextName())
+			join(&json, fd.JSONName())
+		}
+		if subpath != "" && (fd.IsList() || fd.IsMap()) {
+			key, rest, err := nextSegment(subpath, s.pathSep)
+			if err != nil {
+				return "", "", err
+			}
+			both(key)
+			subpath = rest
+		}
+		if subpath == "" {
+			break
+		}
+		if fd.IsMap() {
+			desc = fd.MapValue().Message()
+		} else {
+			desc = fd.Message()
+		}
+		if desc == nil {
+			return "", "", fmt.Errorf("invalid scalar field subpath: %q", subpath)
+		}
+		path = subpath
+	}
+	return text, json, nil
+}
+
+// formatSegments splits the path into its segments and requotes any quoted segments with the given quote.
+func (fm *FieldMask[T]) formatSegments(path string, q byte) []string {
+	var segs []string
+	for path != "" {
+		seg, rest, err := nextSegment(path, fm.pathSep)
+		if err != nil {
+			// Paths are always valid, but fall back to the raw path just in case.
+			return append(segs, path)
+		}
+		if q != '`' && strings.HasPrefix(seg, "`") {
+			if s, err := quote.Unquote(seg, '`'); err == nil {
+				seg = quote.With(s, q)
+			}
+		}
+		segs = append(segs, seg)
+		path = rest
+	}
+	return segs
+}
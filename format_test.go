@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFormat(t *testing.T) {
+	mask := joinMasks(
+		"int32_field",
+		"message_field.int32_field",
+		"message_field.message_field.string_field",
+		"message_field.string_field",
+		"map_string_message_field.`a.b`.int32_field",
+		"map_string_message_field.`a.b`.string_field",
+		"map_string_message_field.foo",
+	)
+	fm, err := Parse[*testpb.Message](mask)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	tests := []struct {
+		name string
+		opts FormatOptions
+		want string
+	}{
+		{
+			name: "default",
+			want: fm.String(),
+		},
+		{
+			name: "separator",
+			opts: FormatOptions{Separator: "\n"},
+			want: "int32_field\n" +
+				"map_string_message_field.`a.b`.int32_field\n" +
+				"map_string_message_field.`a.b`.string_field\n" +
+				"map_string_message_field.foo\n" +
+				"message_field.int32_field\n" +
+				"message_field.message_field.string_field\n" +
+				"message_field.string_field",
+		},
+		{
+			name: "quote",
+			opts: FormatOptions{Separator: " ", Quote: '"'},
+			want: "int32_field " +
+				`map_string_message_field."a.b".int32_field ` +
+				`map_string_message_field."a.b".string_field ` +
+				"map_string_message_field.foo " +
+				"message_field.int32_field " +
+				"message_field.message_field.string_field " +
+				"message_field.string_field",
+		},
+		{
+			name: "group",
+			opts: FormatOptions{Separator: ", ", GroupByField: true},
+			want: "int32_field\n" +
+				"map_string_message_field.`a.b`.int32_field, map_string_message_field.`a.b`.string_field, map_string_message_field.foo\n" +
+				"message_field.int32_field, message_field.message_field.string_field, message_field.string_field",
+		},
+		{
+			name: "indent",
+			opts: FormatOptions{Indent: "  "},
+			want: "int32_field\n" +
+				"map_string_message_field\n" +
+				"  `a.b`\n" +
+				"    int32_field\n" +
+				"    string_field\n" +
+				"  foo\n" +
+				"message_field\n" +
+				"  int32_field\n" +
+				"  message_field\n" +
+				"    string_field\n" +
+				"  string_field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fm.Format(tt.opts); got != tt.want {
+				t.Errorf("Format: unexpected output:\ngot:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathPairs(t *testing.T) {
+	tests := []struct {
+		mask string
+		opts []Option
+		want [][2]string
+	}{
+		{
+			mask: "*",
+			want: [][2]string{{"*", "*"}},
+		},
+		{
+			mask: joinMasks(
+				"int32_field",
+				"message_field.string_field",
+				"map_string_message_field.`a.b`.int32_field",
+				"repeated_message_field.*.bool_field",
+				"repeated_int32_field[-2:]",
+				"struct_field.foo_bar",
+			),
+			want: [][2]string{
+				{"int32_field", "int32Field"},
+				{"map_string_message_field.`a.b`.int32_field", "mapStringMessageField.`a.b`.int32Field"},
+				{"message_field.string_field", "messageField.stringField"},
+				{"repeated_int32_field[-2:]", "repeatedInt32Field[-2:]"},
+				{"repeated_message_field.*.bool_field", "repeatedMessageField.*.boolField"},
+				{"struct_field.foo_bar", "structField.foo_bar"},
+			},
+		},
+		{
+			mask: "messageField.int32Field,mapStringStringField.fooBar",
+			opts: []Option{WithFieldName(JSONFieldName, false)},
+			want: [][2]string{
+				{"map_string_string_field.fooBar", "mapStringStringField.fooBar"},
+				{"message_field.int32_field", "messageField.int32Field"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+		if diff := cmp.Diff(tt.want, fm.PathPairs()); diff != "" {
+			t.Errorf("PathPairs(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"slices"
+
+	"golang.org/x/exp/maps"
+)
+
+// Hash returns a deterministic hash of the mask's normalized paths and the settings that
+// affect its output and behavior, such as the field name mode, the aliases, and the update
+// modes. Masks with the same paths, regardless of the order in which they were added, and
+// the same settings have the same hash. Options that are functions, such as filters and
+// callbacks, only contribute whether or not they're set. Options that only check the paths
+// added to the mask, such as limits and parent masks, don't contribute.
+func (fm *FieldMask[T]) Hash() uint64 {
+	h := hasher{fnv.New64a()}
+	h.string(string(fm.rootDesc.FullName()))
+	paths := fm.msg.paths()
+	slices.Sort(paths)
+	h.int(len(paths))
+	for _, path := range paths {
+		h.string(path)
+	}
+	fm.settings.hash(h)
+	return h.Sum64()
+}
+
+// hash writes the settings that affect the behavior of a mask to the hasher.
+func (s *settings) hash(h hasher) {
+	h.bool(s.extensions)
+	h.bool(s.extResolver != nil)
+	h.bool(s.fieldFilter != nil)
+	h.int(int(s.fieldName))
+	h.int(int(s.pathSep))
+	h.bool(s.globExpansion)
+	h.int(int(s.boolKeyStyle))
+	h.int(int(s.keyEncoding))
+	h.int(int(s.diffPresence))
+	h.bool(s.keyedOverridesWild)
+	h.bool(s.sanitizeFloats)
+	aliases := maps.Keys(s.aliases)
+	slices.Sort(aliases)
+	h.int(len(aliases))
+	for _, alias := range aliases {
+		h.string(alias)
+		h.string(s.aliases[alias])
+	}
+	h.int(len(s.alwaysInclude))
+	for _, path := range s.alwaysInclude {
+		h.string(path)
+	}
+
+	h.int(len(s.mapKeyFilterPaths))
+	for _, kf := range s.mapKeyFilterPaths {
+		h.string(kf.path)
+	}
+	h.int(len(s.mapKeyTransformPaths))
+	for _, kt := range s.mapKeyTransformPaths {
+		h.string(kt.path)
+	}
+	h.int(len(s.enumFilterPaths))
+	for _, ef := range s.enumFilterPaths {
+		h.string(ef.path)
+		allowed := slices.Clone(ef.allowed)
+		slices.Sort(allowed)
+		h.int(len(allowed))
+		for _, n := range allowed {
+			h.int(int(n))
+		}
+	}
+	h.int(len(s.distinctByPaths))
+	for _, db := range s.distinctByPaths {
+		h.string(db.path)
+		h.string(db.subfield)
+	}
+	h.int(len(s.fieldMaxDepthPaths))
+	for _, fmd := range s.fieldMaxDepthPaths {
+		h.string(fmd.path)
+		h.int(fmd.depth)
+	}
+	h.int(len(s.mapValueUpdatePaths))
+	for _, u := range s.mapValueUpdatePaths {
+		h.string(u.path)
+		h.int(int(u.mode))
+		h.int(len(u.keys))
+		for _, key := range u.keys {
+			h.string(key)
+		}
+	}
+
+	h.int(int(s.maskUnknowns))
+	nums := maps.Keys(s.retainUnknowns)
+	slices.Sort(nums)
+	h.int(len(nums))
+	for _, num := range nums {
+		h.int(int(num))
+	}
+	names := maps.Keys(s.dropUnknowns)
+	slices.Sort(names)
+	h.int(len(names))
+	for _, name := range names {
+		h.string(string(name))
+	}
+	h.bool(s.cloneDedupRepeated)
+	h.bool(s.deterministicOrder)
+	h.bool(s.omitEmptyContainers)
+	h.bool(s.dropEmptyMessages)
+	h.bool(s.dropEmptyMapValues)
+	h.bool(s.onClear != nil)
+	h.bool(s.cloneTransform != nil)
+
+	h.int(int(s.updateUnknowns))
+	h.int(int(s.updateRepeated))
+	h.bool(s.updateClearsEmptyMaps)
+	h.bool(s.updateClearsEmptyLists)
+	h.bool(s.updatePrunesEmptyMessages)
+	h.bool(s.updateWrapperPresence)
+	h.bool(s.updateReplacesMessages)
+	h.bool(s.updateSkipsDefaults)
+	h.bool(s.requirePresent)
+	h.string(s.mapDeletePrefix)
+	h.bool(s.leafMerger != nil)
+	h.bool(s.updateCondition != nil)
+	h.bool(s.onUpdateField != nil)
+}
+
+// A hasher writes unambiguous encodings of values to a hash.
+type hasher struct{ hash.Hash64 }
+
+func (h hasher) int(v int) {
+	h.Write(binary.AppendVarint(nil, int64(v)))
+}
+
+func (h hasher) bool(v bool) {
+	h.Write([]byte{boolToByte(v)})
+}
+
+func (h hasher) string(v string) {
+	h.int(len(v))
+	h.Write([]byte(v))
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+)
+
+func TestHash(t *testing.T) {
+	hash := func(t *testing.T, mask string, opts ...Option) uint64 {
+		t.Helper()
+		fm, err := Parse[*testpb.Message](mask, opts...)
+		if err != nil {
+			t.Fatalf("Parse(%q): unexpected error: %v", mask, err)
+		}
+		return fm.Hash()
+	}
+
+	equal := []struct {
+		name string
+		a, b string
+		opts []Option
+	}{
+		{name: "order", a: "int32_field,string_field", b: "string_field,int32_field"},
+		{name: "duplicate", a: "int32_field,int32_field", b: "int32_field"},
+		{name: "subsumed", a: "message_field,message_field.int32_field", b: "message_field"},
+		{name: "wild", a: "map_string_message_field.*.int32_field,map_string_message_field.foo.int32_field", b: "map_string_message_field.*.int32_field"},
+		{name: "complete", a: "*", b: "*,int32_field"},
+		{name: "options", a: "int32_field,string_field", b: "string_field,int32_field", opts: []Option{WithUpdateRepeated(UpdateAppendsRepeated)}},
+	}
+	for _, tt := range equal {
+		t.Run(tt.name, func(t *testing.T) {
+			if a, b := hash(t, tt.a, tt.opts...), hash(t, tt.b, tt.opts...); a != b {
+				t.Errorf("Hash: got different hashes for %q and %q: %x != %x", tt.a, tt.b, a, b)
+			}
+		})
+	}
+
+	seen := make(map[uint64]string)
+	for _, tt := range []struct {
+		name string
+		mask string
+		opts []Option
+	}{
+		{name: "default", mask: "int32_field"},
+		{name: "other-path", mask: "string_field"},
+		{name: "more-paths", mask: "int32_field,string_field"},
+		{name: "complete", mask: "*"},
+		{name: "key", mask: "map_string_string_field.foo"},
+		{name: "field-name", mask: "int32Field", opts: []Option{WithFieldName(JSONFieldName, true)}},
+		{name: "update-repeated", mask: "int32_field", opts: []Option{WithUpdateRepeated(UpdateAppendsRepeated)}},
+		{name: "update-unknowns", mask: "int32_field", opts: []Option{WithUpdateUnknowns(UpdateAppendsUnknowns)}},
+		{name: "mask-unknowns", mask: "int32_field", opts: []Option{WithMaskUnknowns(MaskRetainsUnknowns)}},
+		{name: "clears-maps", mask: "int32_field", opts: []Option{WithUpdateClearsEmptyMaps(true)}},
+		{name: "delete-prefix", mask: "int32_field", opts: []Option{WithUpdateMapDeletePrefix("~")}},
+		{name: "separator", mask: "int32_field", opts: []Option{WithPathSeparator('/')}},
+		{name: "diff-presence", mask: "int32_field", opts: []Option{WithDiffPresence(DiffIgnoresPresence)}},
+		{name: "alias", mask: "int32_field", opts: []Option{WithAlias(map[string]string{"x": "int32_field"})}},
+		{name: "drop-empty-map-values", mask: "int32_field", opts: []Option{WithDropEmptyMapValues(true)}},
+	} {
+		h := hash(t, tt.mask, tt.opts...)
+		if name, ok := seen[h]; ok {
+			t.Errorf("Hash: got the same hash for %q and %q: %x", name, tt.name, h)
+		}
+		seen[h] = tt.name
+	}
+
+	fm, err := Parse[*testpb.Proto2Message]("int32_field")
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if h := fm.Hash(); h == hash(t, "int32_field") {
+		t.Errorf("Hash: got the same hash for different message types: %x", h)
+	}
+}
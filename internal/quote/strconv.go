@@ -93,3 +93,56 @@ func appendEscapedRune(buf []byte, r rune, quote byte) []byte {
 	}
 	return buf
 }
+
+// QuotedPrefix returns the quoted string (as understood by Unquote) at the prefix of s.
+// If s does not start with a valid quoted string, QuotedPrefix returns an error.
+func QuotedPrefix(s string, quote byte) (string, error) {
+	if len(s) == 0 || s[0] != quote {
+		return "", strconv.ErrSyntax
+	}
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			prefix := s[:i+1]
+			if _, err := Unquote(prefix, quote); err != nil {
+				return "", err
+			}
+			return prefix, nil
+		}
+	}
+	return "", strconv.ErrSyntax
+}
+
+// Unquote interprets s as a string quoted with the given quote character,
+// returning the string value that s quotes. It's the inverse of With.
+func Unquote(s string, quote byte) (string, error) {
+	n := len(s)
+	if n < 2 || s[0] != quote || s[n-1] != quote {
+		return "", strconv.ErrSyntax
+	}
+	s = s[1 : n-1]
+	buf := make([]byte, 0, len(s))
+	for len(s) > 0 {
+		switch {
+		case s[0] == quote:
+			return "", strconv.ErrSyntax
+		case len(s) > 1 && s[0] == '\\' && s[1] == quote:
+			buf = append(buf, quote)
+			s = s[2:]
+			continue
+		}
+		c, multibyte, tail, err := strconv.UnquoteChar(s, quote)
+		if err != nil {
+			return "", err
+		}
+		s = tail
+		if c < utf8.RuneSelf || !multibyte {
+			buf = append(buf, byte(c))
+		} else {
+			buf = utf8.AppendRune(buf, c)
+		}
+	}
+	return string(buf), nil
+}
@@ -9,6 +9,10 @@ package testpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -38,7 +42,6 @@ type Message struct {
 	MessageField *Message `protobuf:"bytes,11,opt,name=message_field,json=messageField,proto3" json:"message_field,omitempty"`
 	BytesField   []byte   `protobuf:"bytes,12,opt,name=bytes_field,json=bytesField,proto3" json:"bytes_field,omitempty"`
 	// Types that are assignable to OneofField:
-	//
 	//	*Message_BoolOneofField
 	//	*Message_StringOneofField
 	//	*Message_Int32OneofField
@@ -50,49 +53,61 @@ type Message struct {
 	//	*Message_Fixed32OneofField
 	//	*Message_Fixed64OneofField
 	//	*Message_MessageOneofField
-	OneofField             isMessage_OneofField `protobuf_oneof:"oneof_field"`
-	RepeatedBoolField      []bool               `protobuf:"varint,201,rep,packed,name=repeated_bool_field,json=repeatedBoolField,proto3" json:"repeated_bool_field,omitempty"`
-	RepeatedStringField    []string             `protobuf:"bytes,202,rep,name=repeated_string_field,json=repeatedStringField,proto3" json:"repeated_string_field,omitempty"`
-	RepeatedInt32Field     []int32              `protobuf:"varint,203,rep,packed,name=repeated_int32_field,json=repeatedInt32Field,proto3" json:"repeated_int32_field,omitempty"`
-	RepeatedInt64Field     []int64              `protobuf:"varint,204,rep,packed,name=repeated_int64_field,json=repeatedInt64Field,proto3" json:"repeated_int64_field,omitempty"`
-	RepeatedSint32Field    []int32              `protobuf:"zigzag32,205,rep,packed,name=repeated_sint32_field,json=repeatedSint32Field,proto3" json:"repeated_sint32_field,omitempty"`
-	RepeatedSint64Field    []int64              `protobuf:"zigzag64,206,rep,packed,name=repeated_sint64_field,json=repeatedSint64Field,proto3" json:"repeated_sint64_field,omitempty"`
-	RepeatedUint32Field    []uint32             `protobuf:"varint,207,rep,packed,name=repeated_uint32_field,json=repeatedUint32Field,proto3" json:"repeated_uint32_field,omitempty"`
-	RepeatedUint64Field    []uint64             `protobuf:"varint,208,rep,packed,name=repeated_uint64_field,json=repeatedUint64Field,proto3" json:"repeated_uint64_field,omitempty"`
-	RepeatedFixed32Field   []uint32             `protobuf:"fixed32,209,rep,packed,name=repeated_fixed32_field,json=repeatedFixed32Field,proto3" json:"repeated_fixed32_field,omitempty"`
-	RepeatedFixed64Field   []uint64             `protobuf:"fixed64,210,rep,packed,name=repeated_fixed64_field,json=repeatedFixed64Field,proto3" json:"repeated_fixed64_field,omitempty"`
-	RepeatedMessageField   []*Message           `protobuf:"bytes,211,rep,name=repeated_message_field,json=repeatedMessageField,proto3" json:"repeated_message_field,omitempty"`
-	RepeatedBytesField     [][]byte             `protobuf:"bytes,212,rep,name=repeated_bytes_field,json=repeatedBytesField,proto3" json:"repeated_bytes_field,omitempty"`
-	MapBoolStringField     map[bool]string      `protobuf:"bytes,301,rep,name=map_bool_string_field,json=mapBoolStringField,proto3" json:"map_bool_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapStringStringField   map[string]string    `protobuf:"bytes,302,rep,name=map_string_string_field,json=mapStringStringField,proto3" json:"map_string_string_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt32StringField    map[int32]string     `protobuf:"bytes,303,rep,name=map_int32_string_field,json=mapInt32StringField,proto3" json:"map_int32_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt64StringField    map[int64]string     `protobuf:"bytes,304,rep,name=map_int64_string_field,json=mapInt64StringField,proto3" json:"map_int64_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint32StringField   map[int32]string     `protobuf:"bytes,305,rep,name=map_sint32_string_field,json=mapSint32StringField,proto3" json:"map_sint32_string_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint64StringField   map[int64]string     `protobuf:"bytes,306,rep,name=map_sint64_string_field,json=mapSint64StringField,proto3" json:"map_sint64_string_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint32StringField   map[uint32]string    `protobuf:"bytes,307,rep,name=map_uint32_string_field,json=mapUint32StringField,proto3" json:"map_uint32_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint64StringField   map[uint64]string    `protobuf:"bytes,308,rep,name=map_uint64_string_field,json=mapUint64StringField,proto3" json:"map_uint64_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed32StringField  map[uint32]string    `protobuf:"bytes,309,rep,name=map_fixed32_string_field,json=mapFixed32StringField,proto3" json:"map_fixed32_string_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed64StringField  map[uint64]string    `protobuf:"bytes,310,rep,name=map_fixed64_string_field,json=mapFixed64StringField,proto3" json:"map_fixed64_string_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapBoolBytesField      map[bool][]byte      `protobuf:"bytes,401,rep,name=map_bool_bytes_field,json=mapBoolBytesField,proto3" json:"map_bool_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapStringBytesField    map[string][]byte    `protobuf:"bytes,402,rep,name=map_string_bytes_field,json=mapStringBytesField,proto3" json:"map_string_bytes_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt32BytesField     map[int32][]byte     `protobuf:"bytes,403,rep,name=map_int32_bytes_field,json=mapInt32BytesField,proto3" json:"map_int32_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt64BytesField     map[int64][]byte     `protobuf:"bytes,404,rep,name=map_int64_bytes_field,json=mapInt64BytesField,proto3" json:"map_int64_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint32BytesField    map[int32][]byte     `protobuf:"bytes,405,rep,name=map_sint32_bytes_field,json=mapSint32BytesField,proto3" json:"map_sint32_bytes_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint64BytesField    map[int64][]byte     `protobuf:"bytes,406,rep,name=map_sint64_bytes_field,json=mapSint64BytesField,proto3" json:"map_sint64_bytes_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint32BytesField    map[uint32][]byte    `protobuf:"bytes,407,rep,name=map_uint32_bytes_field,json=mapUint32BytesField,proto3" json:"map_uint32_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint64BytesField    map[uint64][]byte    `protobuf:"bytes,408,rep,name=map_uint64_bytes_field,json=mapUint64BytesField,proto3" json:"map_uint64_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed32BytesField   map[uint32][]byte    `protobuf:"bytes,409,rep,name=map_fixed32_bytes_field,json=mapFixed32BytesField,proto3" json:"map_fixed32_bytes_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed64BytesField   map[uint64][]byte    `protobuf:"bytes,410,rep,name=map_fixed64_bytes_field,json=mapFixed64BytesField,proto3" json:"map_fixed64_bytes_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapBoolMessageField    map[bool]*Message    `protobuf:"bytes,501,rep,name=map_bool_message_field,json=mapBoolMessageField,proto3" json:"map_bool_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapStringMessageField  map[string]*Message  `protobuf:"bytes,502,rep,name=map_string_message_field,json=mapStringMessageField,proto3" json:"map_string_message_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt32MessageField   map[int32]*Message   `protobuf:"bytes,503,rep,name=map_int32_message_field,json=mapInt32MessageField,proto3" json:"map_int32_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapInt64MessageField   map[int64]*Message   `protobuf:"bytes,504,rep,name=map_int64_message_field,json=mapInt64MessageField,proto3" json:"map_int64_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint32MessageField  map[int32]*Message   `protobuf:"bytes,505,rep,name=map_sint32_message_field,json=mapSint32MessageField,proto3" json:"map_sint32_message_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapSint64MessageField  map[int64]*Message   `protobuf:"bytes,506,rep,name=map_sint64_message_field,json=mapSint64MessageField,proto3" json:"map_sint64_message_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint32MessageField  map[uint32]*Message  `protobuf:"bytes,507,rep,name=map_uint32_message_field,json=mapUint32MessageField,proto3" json:"map_uint32_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapUint64MessageField  map[uint64]*Message  `protobuf:"bytes,508,rep,name=map_uint64_message_field,json=mapUint64MessageField,proto3" json:"map_uint64_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed32MessageField map[uint32]*Message  `protobuf:"bytes,509,rep,name=map_fixed32_message_field,json=mapFixed32MessageField,proto3" json:"map_fixed32_message_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapFixed64MessageField map[uint64]*Message  `protobuf:"bytes,510,rep,name=map_fixed64_message_field,json=mapFixed64MessageField,proto3" json:"map_fixed64_message_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OneofField             isMessage_OneofField    `protobuf_oneof:"oneof_field"`
+	RepeatedBoolField      []bool                  `protobuf:"varint,201,rep,packed,name=repeated_bool_field,json=repeatedBoolField,proto3" json:"repeated_bool_field,omitempty"`
+	RepeatedStringField    []string                `protobuf:"bytes,202,rep,name=repeated_string_field,json=repeatedStringField,proto3" json:"repeated_string_field,omitempty"`
+	RepeatedInt32Field     []int32                 `protobuf:"varint,203,rep,packed,name=repeated_int32_field,json=repeatedInt32Field,proto3" json:"repeated_int32_field,omitempty"`
+	RepeatedInt64Field     []int64                 `protobuf:"varint,204,rep,packed,name=repeated_int64_field,json=repeatedInt64Field,proto3" json:"repeated_int64_field,omitempty"`
+	RepeatedSint32Field    []int32                 `protobuf:"zigzag32,205,rep,packed,name=repeated_sint32_field,json=repeatedSint32Field,proto3" json:"repeated_sint32_field,omitempty"`
+	RepeatedSint64Field    []int64                 `protobuf:"zigzag64,206,rep,packed,name=repeated_sint64_field,json=repeatedSint64Field,proto3" json:"repeated_sint64_field,omitempty"`
+	RepeatedUint32Field    []uint32                `protobuf:"varint,207,rep,packed,name=repeated_uint32_field,json=repeatedUint32Field,proto3" json:"repeated_uint32_field,omitempty"`
+	RepeatedUint64Field    []uint64                `protobuf:"varint,208,rep,packed,name=repeated_uint64_field,json=repeatedUint64Field,proto3" json:"repeated_uint64_field,omitempty"`
+	RepeatedFixed32Field   []uint32                `protobuf:"fixed32,209,rep,packed,name=repeated_fixed32_field,json=repeatedFixed32Field,proto3" json:"repeated_fixed32_field,omitempty"`
+	RepeatedFixed64Field   []uint64                `protobuf:"fixed64,210,rep,packed,name=repeated_fixed64_field,json=repeatedFixed64Field,proto3" json:"repeated_fixed64_field,omitempty"`
+	RepeatedMessageField   []*Message              `protobuf:"bytes,211,rep,name=repeated_message_field,json=repeatedMessageField,proto3" json:"repeated_message_field,omitempty"`
+	RepeatedBytesField     [][]byte                `protobuf:"bytes,212,rep,name=repeated_bytes_field,json=repeatedBytesField,proto3" json:"repeated_bytes_field,omitempty"`
+	MapBoolStringField     map[bool]string         `protobuf:"bytes,301,rep,name=map_bool_string_field,json=mapBoolStringField,proto3" json:"map_bool_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapStringStringField   map[string]string       `protobuf:"bytes,302,rep,name=map_string_string_field,json=mapStringStringField,proto3" json:"map_string_string_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt32StringField    map[int32]string        `protobuf:"bytes,303,rep,name=map_int32_string_field,json=mapInt32StringField,proto3" json:"map_int32_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt64StringField    map[int64]string        `protobuf:"bytes,304,rep,name=map_int64_string_field,json=mapInt64StringField,proto3" json:"map_int64_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint32StringField   map[int32]string        `protobuf:"bytes,305,rep,name=map_sint32_string_field,json=mapSint32StringField,proto3" json:"map_sint32_string_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint64StringField   map[int64]string        `protobuf:"bytes,306,rep,name=map_sint64_string_field,json=mapSint64StringField,proto3" json:"map_sint64_string_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint32StringField   map[uint32]string       `protobuf:"bytes,307,rep,name=map_uint32_string_field,json=mapUint32StringField,proto3" json:"map_uint32_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint64StringField   map[uint64]string       `protobuf:"bytes,308,rep,name=map_uint64_string_field,json=mapUint64StringField,proto3" json:"map_uint64_string_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed32StringField  map[uint32]string       `protobuf:"bytes,309,rep,name=map_fixed32_string_field,json=mapFixed32StringField,proto3" json:"map_fixed32_string_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed64StringField  map[uint64]string       `protobuf:"bytes,310,rep,name=map_fixed64_string_field,json=mapFixed64StringField,proto3" json:"map_fixed64_string_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapBoolBytesField      map[bool][]byte         `protobuf:"bytes,401,rep,name=map_bool_bytes_field,json=mapBoolBytesField,proto3" json:"map_bool_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapStringBytesField    map[string][]byte       `protobuf:"bytes,402,rep,name=map_string_bytes_field,json=mapStringBytesField,proto3" json:"map_string_bytes_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt32BytesField     map[int32][]byte        `protobuf:"bytes,403,rep,name=map_int32_bytes_field,json=mapInt32BytesField,proto3" json:"map_int32_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt64BytesField     map[int64][]byte        `protobuf:"bytes,404,rep,name=map_int64_bytes_field,json=mapInt64BytesField,proto3" json:"map_int64_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint32BytesField    map[int32][]byte        `protobuf:"bytes,405,rep,name=map_sint32_bytes_field,json=mapSint32BytesField,proto3" json:"map_sint32_bytes_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint64BytesField    map[int64][]byte        `protobuf:"bytes,406,rep,name=map_sint64_bytes_field,json=mapSint64BytesField,proto3" json:"map_sint64_bytes_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint32BytesField    map[uint32][]byte       `protobuf:"bytes,407,rep,name=map_uint32_bytes_field,json=mapUint32BytesField,proto3" json:"map_uint32_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint64BytesField    map[uint64][]byte       `protobuf:"bytes,408,rep,name=map_uint64_bytes_field,json=mapUint64BytesField,proto3" json:"map_uint64_bytes_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed32BytesField   map[uint32][]byte       `protobuf:"bytes,409,rep,name=map_fixed32_bytes_field,json=mapFixed32BytesField,proto3" json:"map_fixed32_bytes_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed64BytesField   map[uint64][]byte       `protobuf:"bytes,410,rep,name=map_fixed64_bytes_field,json=mapFixed64BytesField,proto3" json:"map_fixed64_bytes_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapBoolMessageField    map[bool]*Message       `protobuf:"bytes,501,rep,name=map_bool_message_field,json=mapBoolMessageField,proto3" json:"map_bool_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapStringMessageField  map[string]*Message     `protobuf:"bytes,502,rep,name=map_string_message_field,json=mapStringMessageField,proto3" json:"map_string_message_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt32MessageField   map[int32]*Message      `protobuf:"bytes,503,rep,name=map_int32_message_field,json=mapInt32MessageField,proto3" json:"map_int32_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapInt64MessageField   map[int64]*Message      `protobuf:"bytes,504,rep,name=map_int64_message_field,json=mapInt64MessageField,proto3" json:"map_int64_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint32MessageField  map[int32]*Message      `protobuf:"bytes,505,rep,name=map_sint32_message_field,json=mapSint32MessageField,proto3" json:"map_sint32_message_field,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapSint64MessageField  map[int64]*Message      `protobuf:"bytes,506,rep,name=map_sint64_message_field,json=mapSint64MessageField,proto3" json:"map_sint64_message_field,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint32MessageField  map[uint32]*Message     `protobuf:"bytes,507,rep,name=map_uint32_message_field,json=mapUint32MessageField,proto3" json:"map_uint32_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUint64MessageField  map[uint64]*Message     `protobuf:"bytes,508,rep,name=map_uint64_message_field,json=mapUint64MessageField,proto3" json:"map_uint64_message_field,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed32MessageField map[uint32]*Message     `protobuf:"bytes,509,rep,name=map_fixed32_message_field,json=mapFixed32MessageField,proto3" json:"map_fixed32_message_field,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapFixed64MessageField map[uint64]*Message     `protobuf:"bytes,510,rep,name=map_fixed64_message_field,json=mapFixed64MessageField,proto3" json:"map_fixed64_message_field,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StructField            *structpb.Struct        `protobuf:"bytes,601,opt,name=struct_field,json=structField,proto3" json:"struct_field,omitempty"`
+	ValueField             *structpb.Value         `protobuf:"bytes,602,opt,name=value_field,json=valueField,proto3" json:"value_field,omitempty"`
+	ListValueField         *structpb.ListValue     `protobuf:"bytes,603,opt,name=list_value_field,json=listValueField,proto3" json:"list_value_field,omitempty"`
+	AnyField               *anypb.Any              `protobuf:"bytes,701,opt,name=any_field,json=anyField,proto3" json:"any_field,omitempty"`
+	RepeatedAnyField       []*anypb.Any            `protobuf:"bytes,702,rep,name=repeated_any_field,json=repeatedAnyField,proto3" json:"repeated_any_field,omitempty"`
+	MapStringAnyField      map[string]*anypb.Any   `protobuf:"bytes,703,rep,name=map_string_any_field,json=mapStringAnyField,proto3" json:"map_string_any_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WrappedInt32Value      *wrapperspb.Int32Value  `protobuf:"bytes,801,opt,name=wrapped_int32_value,json=wrappedInt32Value,proto3" json:"wrapped_int32_value,omitempty"`
+	WrappedStringValue     *wrapperspb.StringValue `protobuf:"bytes,802,opt,name=wrapped_string_value,json=wrappedStringValue,proto3" json:"wrapped_string_value,omitempty"`
+	DoubleField            float64                 `protobuf:"fixed64,901,opt,name=double_field,json=doubleField,proto3" json:"double_field,omitempty"`
+	FloatField             float32                 `protobuf:"fixed32,902,opt,name=float_field,json=floatField,proto3" json:"float_field,omitempty"`
+	RepeatedDoubleField    []float64               `protobuf:"fixed64,903,rep,packed,name=repeated_double_field,json=repeatedDoubleField,proto3" json:"repeated_double_field,omitempty"`
+	MapStringDoubleField   map[string]float64      `protobuf:"bytes,904,rep,name=map_string_double_field,json=mapStringDoubleField,proto3" json:"map_string_double_field,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 }
 
 func (x *Message) Reset() {
@@ -589,6 +604,90 @@ func (x *Message) GetMapFixed64MessageField() map[uint64]*Message {
 	return nil
 }
 
+func (x *Message) GetStructField() *structpb.Struct {
+	if x != nil {
+		return x.StructField
+	}
+	return nil
+}
+
+func (x *Message) GetValueField() *structpb.Value {
+	if x != nil {
+		return x.ValueField
+	}
+	return nil
+}
+
+func (x *Message) GetListValueField() *structpb.ListValue {
+	if x != nil {
+		return x.ListValueField
+	}
+	return nil
+}
+
+func (x *Message) GetAnyField() *anypb.Any {
+	if x != nil {
+		return x.AnyField
+	}
+	return nil
+}
+
+func (x *Message) GetRepeatedAnyField() []*anypb.Any {
+	if x != nil {
+		return x.RepeatedAnyField
+	}
+	return nil
+}
+
+func (x *Message) GetMapStringAnyField() map[string]*anypb.Any {
+	if x != nil {
+		return x.MapStringAnyField
+	}
+	return nil
+}
+
+func (x *Message) GetWrappedInt32Value() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.WrappedInt32Value
+	}
+	return nil
+}
+
+func (x *Message) GetWrappedStringValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WrappedStringValue
+	}
+	return nil
+}
+
+func (x *Message) GetDoubleField() float64 {
+	if x != nil {
+		return x.DoubleField
+	}
+	return 0
+}
+
+func (x *Message) GetFloatField() float32 {
+	if x != nil {
+		return x.FloatField
+	}
+	return 0
+}
+
+func (x *Message) GetRepeatedDoubleField() []float64 {
+	if x != nil {
+		return x.RepeatedDoubleField
+	}
+	return nil
+}
+
+func (x *Message) GetMapStringDoubleField() map[string]float64 {
+	if x != nil {
+		return x.MapStringDoubleField
+	}
+	return nil
+}
+
 type isMessage_OneofField interface {
 	isMessage_OneofField()
 }
@@ -659,510 +758,656 @@ func (*Message_Fixed64OneofField) isMessage_OneofField() {}
 
 func (*Message_MessageOneofField) isMessage_OneofField() {}
 
+type UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message    *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Nested     *UpdateRequest         `protobuf:"bytes,3,opt,name=nested,proto3" json:"nested,omitempty"`
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testpb_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_testpb_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_testpb_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRequest) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetNested() *UpdateRequest {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
 var File_internal_testpb_test_proto protoreflect.FileDescriptor
 
 var file_internal_testpb_test_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
 	0x62, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x64, 0x65,
 	0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x3d, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11,
-	0x52, 0x0b, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x12, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0c, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x06, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x4a, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75,
-	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73,
-	0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a,
-	0x10, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x6f, 0x6f, 0x6c, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x66, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x67,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x68, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28,
-	0x11, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6a, 0x20, 0x01, 0x28,
-	0x12, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6b, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x00, 0x52, 0x10, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6c, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x00, 0x52, 0x10, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6d, 0x20, 0x01,
-	0x28, 0x07, 0x48, 0x00, 0x52, 0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6e,
-	0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
-	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
-	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
-	0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x03, 0x28, 0x08,
-	0x52, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xca, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0xcb, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0xcc, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33,
-	0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xcd, 0x01, 0x20, 0x03, 0x28, 0x11, 0x52, 0x13,
-	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xce, 0x01, 0x20,
-	0x03, 0x28, 0x12, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0xcf, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a,
-	0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd0, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x13, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd1, 0x01, 0x20,
-	0x03, 0x28, 0x07, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0xd2, 0x01, 0x20, 0x03, 0x28, 0x06, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x5c, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd3, 0x01, 0x20, 0x03, 0x28,
+	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x45, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0b, 0x73, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x12, 0x52, 0x0b,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x06, 0x52, 0x0c, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
+	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x11, 0x48, 0x00, 0x52, 0x10,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x12, 0x48, 0x00, 0x52, 0x10,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x10,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x10,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x30, 0x0a, 0x13, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x07, 0x48, 0x00, 0x52,
+	0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x06, 0x48,
+	0x00, 0x52, 0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x6f, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
 	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31,
-	0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd4, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x71, 0x0a, 0x15, 0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xad, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3d, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2f, 0x0a,
+	0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0xc9, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33,
+	0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xca, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xcc,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0xcd, 0x01, 0x20, 0x03, 0x28, 0x11, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33,
+	0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xce, 0x01, 0x20, 0x03, 0x28, 0x12, 0x52, 0x13,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xcf, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0xd0, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x35, 0x0a,
+	0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd1, 0x01, 0x20, 0x03, 0x28, 0x07, 0x52, 0x14,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd2,
+	0x01, 0x20, 0x03, 0x28, 0x06, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5c, 0x0a, 0x16, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd3, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0xd4, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x71, 0x0a, 0x15,
+	0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xad, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d, 0x61, 0x70,
+	0x42, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xae, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
 	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x12, 0x6d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0xae, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0xaf, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74,
+	0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb0, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0xb1, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
 	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
 	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a,
-	0x16, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xaf, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13,
-	0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb0, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0xb1, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65,
+	0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a,
+	0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb2, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x14, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0xb3, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb4, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0xb5, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65,
 	0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c,
 	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61,
-	0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb2, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d,
-	0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb3, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
-	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
-	0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0xb4, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d,
+	0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0xb6, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75,
+	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x6e, 0x0a, 0x14, 0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x91, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d,
+	0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x92, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x71, 0x0a, 0x15, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x93, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
 	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
 	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61,
-	0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a,
-	0x18, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb5, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68,
-	0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xb6, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64,
-	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15,
-	0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x6e, 0x0a, 0x14, 0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x91, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42,
-	0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x11, 0x6d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x92, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x71, 0x0a, 0x15, 0x6d, 0x61, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x94, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16,
+	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x95, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d,
+	0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x96, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76,
+	0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x97, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74,
+	0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x98, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x99, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
 	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
 	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x71, 0x0a, 0x15, 0x6d,
-	0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x93, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x64, 0x65,
-	0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d, 0x61, 0x70, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x71,
-	0x0a, 0x15, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x94, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d,
-	0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x95, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
-	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x96, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
-	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
-	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a,
-	0x16, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x97, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13,
-	0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x98, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x99, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65,
-	0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61,
 	0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x9a, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d,
-	0x61, 0x70, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf5, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64,
-	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61,
-	0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf6, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a,
-	0x17, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf7, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a,
+	0x17, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x9a, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
 	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x14, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0xf8, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
-	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
-	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf9, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
-	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d,
-	0x61, 0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfa, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40,
-	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x15, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x75,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x14, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x74, 0x0a, 0x16, 0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0xf5, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75,
+	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18,
+	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf6, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f,
 	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0xfb, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76,
+	0x65, 0x6c, 0x64, 0x18, 0xf7, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76,
 	0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61,
-	0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0xfc, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
-	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
-	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61,
-	0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x7d, 0x0a, 0x19, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfd, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76,
+	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf8, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76,
 	0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7d,
-	0x0a, 0x19, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfe, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
-	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x45, 0x0a,
-	0x17, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61,
+	0x70, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xf9, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x15, 0x6d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0xfa, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70,
+	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x7a, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfb,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73,
+	0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7a,
+	0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfc, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x40, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7d, 0x0a, 0x19, 0x6d, 0x61,
+	0x70, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfd, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x16, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7d, 0x0a, 0x19, 0x6d, 0x61, 0x70,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xfe, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x16, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd9, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0xda, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x45, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0xdb, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x6e, 0x79, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0xbd, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x08, 0x61, 0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x43, 0x0a, 0x12, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0xbe, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x10, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x6e, 0x0a, 0x14, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6e,
+	0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xbf, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x4c, 0x0a, 0x13, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0xa1, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a,
+	0x14, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0xa2, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x85,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x86, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x87, 0x07,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x17, 0x6d, 0x61, 0x70,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x88, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x65,
+	0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x1a, 0x45, 0x0a, 0x17, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61,
+	0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d,
+	0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a,
-	0x18, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a,
-	0x19, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x19, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x48, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x4d, 0x61,
+	0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x4d, 0x61, 0x70, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x55,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x4d,
-	0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d,
-	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x4d, 0x61,
-	0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70,
-	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61,
-	0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x42, 0x6f, 0x6f, 0x6c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68,
-	0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
-	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x6e, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
-	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x6e, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
-	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
-	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76,
-	0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
-	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x45, 0x0a, 0x17, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46,
+	0x0a, 0x18, 0x4d, 0x61, 0x70, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46,
+	0x0a, 0x18, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x18, 0x4d, 0x61, 0x70, 0x42,
+	0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73,
 	0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
 	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a, 0x1b, 0x4d, 0x61, 0x70, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6e, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6e, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70, 0x53,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c,
 	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75,
 	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73,
 	0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a, 0x1b, 0x4d, 0x61, 0x70,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61, 0x70,
+	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d, 0x61,
+	0x70, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e,
 	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
 	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x29, 0x5a, 0x27, 0x62, 0x75,
-	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x1a, 0x4d,
+	0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76,
+	0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a, 0x1b,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64,
+	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70,
+	0x0a, 0x1b, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x5a, 0x0a, 0x16, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75,
+	0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x12, 0x43, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61,
+	0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x29, 0x5a, 0x27, 0x62, 0x75, 0x72,
+	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x6d, 0x61, 0x73, 0x6b, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1177,89 +1422,112 @@ func file_internal_testpb_test_proto_rawDescGZIP() []byte {
 	return file_internal_testpb_test_proto_rawDescData
 }
 
-var file_internal_testpb_test_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_internal_testpb_test_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_internal_testpb_test_proto_goTypes = []interface{}{
-	(*Message)(nil), // 0: dev.bursavich.fieldmask.test.Message
-	nil,             // 1: dev.bursavich.fieldmask.test.Message.MapBoolStringFieldEntry
-	nil,             // 2: dev.bursavich.fieldmask.test.Message.MapStringStringFieldEntry
-	nil,             // 3: dev.bursavich.fieldmask.test.Message.MapInt32StringFieldEntry
-	nil,             // 4: dev.bursavich.fieldmask.test.Message.MapInt64StringFieldEntry
-	nil,             // 5: dev.bursavich.fieldmask.test.Message.MapSint32StringFieldEntry
-	nil,             // 6: dev.bursavich.fieldmask.test.Message.MapSint64StringFieldEntry
-	nil,             // 7: dev.bursavich.fieldmask.test.Message.MapUint32StringFieldEntry
-	nil,             // 8: dev.bursavich.fieldmask.test.Message.MapUint64StringFieldEntry
-	nil,             // 9: dev.bursavich.fieldmask.test.Message.MapFixed32StringFieldEntry
-	nil,             // 10: dev.bursavich.fieldmask.test.Message.MapFixed64StringFieldEntry
-	nil,             // 11: dev.bursavich.fieldmask.test.Message.MapBoolBytesFieldEntry
-	nil,             // 12: dev.bursavich.fieldmask.test.Message.MapStringBytesFieldEntry
-	nil,             // 13: dev.bursavich.fieldmask.test.Message.MapInt32BytesFieldEntry
-	nil,             // 14: dev.bursavich.fieldmask.test.Message.MapInt64BytesFieldEntry
-	nil,             // 15: dev.bursavich.fieldmask.test.Message.MapSint32BytesFieldEntry
-	nil,             // 16: dev.bursavich.fieldmask.test.Message.MapSint64BytesFieldEntry
-	nil,             // 17: dev.bursavich.fieldmask.test.Message.MapUint32BytesFieldEntry
-	nil,             // 18: dev.bursavich.fieldmask.test.Message.MapUint64BytesFieldEntry
-	nil,             // 19: dev.bursavich.fieldmask.test.Message.MapFixed32BytesFieldEntry
-	nil,             // 20: dev.bursavich.fieldmask.test.Message.MapFixed64BytesFieldEntry
-	nil,             // 21: dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry
-	nil,             // 22: dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry
-	nil,             // 23: dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry
-	nil,             // 24: dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry
-	nil,             // 25: dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry
-	nil,             // 26: dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry
-	nil,             // 27: dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry
-	nil,             // 28: dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry
-	nil,             // 29: dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry
-	nil,             // 30: dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry
+	(*Message)(nil),                // 0: dev.bursavich.fieldmask.test.Message
+	(*UpdateRequest)(nil),          // 1: dev.bursavich.fieldmask.test.UpdateRequest
+	nil,                            // 2: dev.bursavich.fieldmask.test.Message.MapBoolStringFieldEntry
+	nil,                            // 3: dev.bursavich.fieldmask.test.Message.MapStringStringFieldEntry
+	nil,                            // 4: dev.bursavich.fieldmask.test.Message.MapInt32StringFieldEntry
+	nil,                            // 5: dev.bursavich.fieldmask.test.Message.MapInt64StringFieldEntry
+	nil,                            // 6: dev.bursavich.fieldmask.test.Message.MapSint32StringFieldEntry
+	nil,                            // 7: dev.bursavich.fieldmask.test.Message.MapSint64StringFieldEntry
+	nil,                            // 8: dev.bursavich.fieldmask.test.Message.MapUint32StringFieldEntry
+	nil,                            // 9: dev.bursavich.fieldmask.test.Message.MapUint64StringFieldEntry
+	nil,                            // 10: dev.bursavich.fieldmask.test.Message.MapFixed32StringFieldEntry
+	nil,                            // 11: dev.bursavich.fieldmask.test.Message.MapFixed64StringFieldEntry
+	nil,                            // 12: dev.bursavich.fieldmask.test.Message.MapBoolBytesFieldEntry
+	nil,                            // 13: dev.bursavich.fieldmask.test.Message.MapStringBytesFieldEntry
+	nil,                            // 14: dev.bursavich.fieldmask.test.Message.MapInt32BytesFieldEntry
+	nil,                            // 15: dev.bursavich.fieldmask.test.Message.MapInt64BytesFieldEntry
+	nil,                            // 16: dev.bursavich.fieldmask.test.Message.MapSint32BytesFieldEntry
+	nil,                            // 17: dev.bursavich.fieldmask.test.Message.MapSint64BytesFieldEntry
+	nil,                            // 18: dev.bursavich.fieldmask.test.Message.MapUint32BytesFieldEntry
+	nil,                            // 19: dev.bursavich.fieldmask.test.Message.MapUint64BytesFieldEntry
+	nil,                            // 20: dev.bursavich.fieldmask.test.Message.MapFixed32BytesFieldEntry
+	nil,                            // 21: dev.bursavich.fieldmask.test.Message.MapFixed64BytesFieldEntry
+	nil,                            // 22: dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry
+	nil,                            // 23: dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry
+	nil,                            // 24: dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry
+	nil,                            // 25: dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry
+	nil,                            // 26: dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry
+	nil,                            // 27: dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry
+	nil,                            // 28: dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry
+	nil,                            // 29: dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry
+	nil,                            // 30: dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry
+	nil,                            // 31: dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry
+	nil,                            // 32: dev.bursavich.fieldmask.test.Message.MapStringAnyFieldEntry
+	nil,                            // 33: dev.bursavich.fieldmask.test.Message.MapStringDoubleFieldEntry
+	(*structpb.Struct)(nil),        // 34: google.protobuf.Struct
+	(*structpb.Value)(nil),         // 35: google.protobuf.Value
+	(*structpb.ListValue)(nil),     // 36: google.protobuf.ListValue
+	(*anypb.Any)(nil),              // 37: google.protobuf.Any
+	(*wrapperspb.Int32Value)(nil),  // 38: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil), // 39: google.protobuf.StringValue
+	(*fieldmaskpb.FieldMask)(nil),  // 40: google.protobuf.FieldMask
 }
 var file_internal_testpb_test_proto_depIdxs = []int32{
 	0,  // 0: dev.bursavich.fieldmask.test.Message.message_field:type_name -> dev.bursavich.fieldmask.test.Message
 	0,  // 1: dev.bursavich.fieldmask.test.Message.message_oneof_field:type_name -> dev.bursavich.fieldmask.test.Message
 	0,  // 2: dev.bursavich.fieldmask.test.Message.repeated_message_field:type_name -> dev.bursavich.fieldmask.test.Message
-	1,  // 3: dev.bursavich.fieldmask.test.Message.map_bool_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolStringFieldEntry
-	2,  // 4: dev.bursavich.fieldmask.test.Message.map_string_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringStringFieldEntry
-	3,  // 5: dev.bursavich.fieldmask.test.Message.map_int32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32StringFieldEntry
-	4,  // 6: dev.bursavich.fieldmask.test.Message.map_int64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64StringFieldEntry
-	5,  // 7: dev.bursavich.fieldmask.test.Message.map_sint32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32StringFieldEntry
-	6,  // 8: dev.bursavich.fieldmask.test.Message.map_sint64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64StringFieldEntry
-	7,  // 9: dev.bursavich.fieldmask.test.Message.map_uint32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32StringFieldEntry
-	8,  // 10: dev.bursavich.fieldmask.test.Message.map_uint64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64StringFieldEntry
-	9,  // 11: dev.bursavich.fieldmask.test.Message.map_fixed32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32StringFieldEntry
-	10, // 12: dev.bursavich.fieldmask.test.Message.map_fixed64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64StringFieldEntry
-	11, // 13: dev.bursavich.fieldmask.test.Message.map_bool_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolBytesFieldEntry
-	12, // 14: dev.bursavich.fieldmask.test.Message.map_string_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringBytesFieldEntry
-	13, // 15: dev.bursavich.fieldmask.test.Message.map_int32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32BytesFieldEntry
-	14, // 16: dev.bursavich.fieldmask.test.Message.map_int64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64BytesFieldEntry
-	15, // 17: dev.bursavich.fieldmask.test.Message.map_sint32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32BytesFieldEntry
-	16, // 18: dev.bursavich.fieldmask.test.Message.map_sint64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64BytesFieldEntry
-	17, // 19: dev.bursavich.fieldmask.test.Message.map_uint32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32BytesFieldEntry
-	18, // 20: dev.bursavich.fieldmask.test.Message.map_uint64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64BytesFieldEntry
-	19, // 21: dev.bursavich.fieldmask.test.Message.map_fixed32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32BytesFieldEntry
-	20, // 22: dev.bursavich.fieldmask.test.Message.map_fixed64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64BytesFieldEntry
-	21, // 23: dev.bursavich.fieldmask.test.Message.map_bool_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry
-	22, // 24: dev.bursavich.fieldmask.test.Message.map_string_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry
-	23, // 25: dev.bursavich.fieldmask.test.Message.map_int32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry
-	24, // 26: dev.bursavich.fieldmask.test.Message.map_int64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry
-	25, // 27: dev.bursavich.fieldmask.test.Message.map_sint32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry
-	26, // 28: dev.bursavich.fieldmask.test.Message.map_sint64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry
-	27, // 29: dev.bursavich.fieldmask.test.Message.map_uint32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry
-	28, // 30: dev.bursavich.fieldmask.test.Message.map_uint64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry
-	29, // 31: dev.bursavich.fieldmask.test.Message.map_fixed32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry
-	30, // 32: dev.bursavich.fieldmask.test.Message.map_fixed64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry
-	0,  // 33: dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 34: dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 35: dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 36: dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 37: dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 38: dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 39: dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 40: dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 41: dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	0,  // 42: dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
-	43, // [43:43] is the sub-list for method output_type
-	43, // [43:43] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	2,  // 3: dev.bursavich.fieldmask.test.Message.map_bool_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolStringFieldEntry
+	3,  // 4: dev.bursavich.fieldmask.test.Message.map_string_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringStringFieldEntry
+	4,  // 5: dev.bursavich.fieldmask.test.Message.map_int32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32StringFieldEntry
+	5,  // 6: dev.bursavich.fieldmask.test.Message.map_int64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64StringFieldEntry
+	6,  // 7: dev.bursavich.fieldmask.test.Message.map_sint32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32StringFieldEntry
+	7,  // 8: dev.bursavich.fieldmask.test.Message.map_sint64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64StringFieldEntry
+	8,  // 9: dev.bursavich.fieldmask.test.Message.map_uint32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32StringFieldEntry
+	9,  // 10: dev.bursavich.fieldmask.test.Message.map_uint64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64StringFieldEntry
+	10, // 11: dev.bursavich.fieldmask.test.Message.map_fixed32_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32StringFieldEntry
+	11, // 12: dev.bursavich.fieldmask.test.Message.map_fixed64_string_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64StringFieldEntry
+	12, // 13: dev.bursavich.fieldmask.test.Message.map_bool_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolBytesFieldEntry
+	13, // 14: dev.bursavich.fieldmask.test.Message.map_string_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringBytesFieldEntry
+	14, // 15: dev.bursavich.fieldmask.test.Message.map_int32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32BytesFieldEntry
+	15, // 16: dev.bursavich.fieldmask.test.Message.map_int64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64BytesFieldEntry
+	16, // 17: dev.bursavich.fieldmask.test.Message.map_sint32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32BytesFieldEntry
+	17, // 18: dev.bursavich.fieldmask.test.Message.map_sint64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64BytesFieldEntry
+	18, // 19: dev.bursavich.fieldmask.test.Message.map_uint32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32BytesFieldEntry
+	19, // 20: dev.bursavich.fieldmask.test.Message.map_uint64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64BytesFieldEntry
+	20, // 21: dev.bursavich.fieldmask.test.Message.map_fixed32_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32BytesFieldEntry
+	21, // 22: dev.bursavich.fieldmask.test.Message.map_fixed64_bytes_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64BytesFieldEntry
+	22, // 23: dev.bursavich.fieldmask.test.Message.map_bool_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry
+	23, // 24: dev.bursavich.fieldmask.test.Message.map_string_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry
+	24, // 25: dev.bursavich.fieldmask.test.Message.map_int32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry
+	25, // 26: dev.bursavich.fieldmask.test.Message.map_int64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry
+	26, // 27: dev.bursavich.fieldmask.test.Message.map_sint32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry
+	27, // 28: dev.bursavich.fieldmask.test.Message.map_sint64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry
+	28, // 29: dev.bursavich.fieldmask.test.Message.map_uint32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry
+	29, // 30: dev.bursavich.fieldmask.test.Message.map_uint64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry
+	30, // 31: dev.bursavich.fieldmask.test.Message.map_fixed32_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry
+	31, // 32: dev.bursavich.fieldmask.test.Message.map_fixed64_message_field:type_name -> dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry
+	34, // 33: dev.bursavich.fieldmask.test.Message.struct_field:type_name -> google.protobuf.Struct
+	35, // 34: dev.bursavich.fieldmask.test.Message.value_field:type_name -> google.protobuf.Value
+	36, // 35: dev.bursavich.fieldmask.test.Message.list_value_field:type_name -> google.protobuf.ListValue
+	37, // 36: dev.bursavich.fieldmask.test.Message.any_field:type_name -> google.protobuf.Any
+	37, // 37: dev.bursavich.fieldmask.test.Message.repeated_any_field:type_name -> google.protobuf.Any
+	32, // 38: dev.bursavich.fieldmask.test.Message.map_string_any_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringAnyFieldEntry
+	38, // 39: dev.bursavich.fieldmask.test.Message.wrapped_int32_value:type_name -> google.protobuf.Int32Value
+	39, // 40: dev.bursavich.fieldmask.test.Message.wrapped_string_value:type_name -> google.protobuf.StringValue
+	33, // 41: dev.bursavich.fieldmask.test.Message.map_string_double_field:type_name -> dev.bursavich.fieldmask.test.Message.MapStringDoubleFieldEntry
+	0,  // 42: dev.bursavich.fieldmask.test.UpdateRequest.message:type_name -> dev.bursavich.fieldmask.test.Message
+	40, // 43: dev.bursavich.fieldmask.test.UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 44: dev.bursavich.fieldmask.test.UpdateRequest.nested:type_name -> dev.bursavich.fieldmask.test.UpdateRequest
+	0,  // 45: dev.bursavich.fieldmask.test.Message.MapBoolMessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 46: dev.bursavich.fieldmask.test.Message.MapStringMessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 47: dev.bursavich.fieldmask.test.Message.MapInt32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 48: dev.bursavich.fieldmask.test.Message.MapInt64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 49: dev.bursavich.fieldmask.test.Message.MapSint32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 50: dev.bursavich.fieldmask.test.Message.MapSint64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 51: dev.bursavich.fieldmask.test.Message.MapUint32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 52: dev.bursavich.fieldmask.test.Message.MapUint64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 53: dev.bursavich.fieldmask.test.Message.MapFixed32MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	0,  // 54: dev.bursavich.fieldmask.test.Message.MapFixed64MessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Message
+	37, // 55: dev.bursavich.fieldmask.test.Message.MapStringAnyFieldEntry.value:type_name -> google.protobuf.Any
+	56, // [56:56] is the sub-list for method output_type
+	56, // [56:56] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_internal_testpb_test_proto_init() }
@@ -1280,6 +1548,18 @@ func file_internal_testpb_test_proto_init() {
 				return nil
 			}
 		}
+		file_internal_testpb_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_internal_testpb_test_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Message_BoolOneofField)(nil),
@@ -1300,7 +1580,7 @@ func file_internal_testpb_test_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_testpb_test_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,871 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.24.2
+// source: internal/testpb/test2.proto
+
+package testpb
+
+import (
+	_ "bursavich.dev/fieldmask/maskpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Proto2Message_Color int32
+
+const (
+	Proto2Message_COLOR_UNSPECIFIED Proto2Message_Color = 0
+	Proto2Message_RED               Proto2Message_Color = 1
+	Proto2Message_GREEN             Proto2Message_Color = 2
+	Proto2Message_BLUE              Proto2Message_Color = 3
+)
+
+// Enum value maps for Proto2Message_Color.
+var (
+	Proto2Message_Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "RED",
+		2: "GREEN",
+		3: "BLUE",
+	}
+	Proto2Message_Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"RED":               1,
+		"GREEN":             2,
+		"BLUE":              3,
+	}
+)
+
+func (x Proto2Message_Color) Enum() *Proto2Message_Color {
+	p := new(Proto2Message_Color)
+	*p = x
+	return p
+}
+
+func (x Proto2Message_Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Proto2Message_Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_testpb_test2_proto_enumTypes[0].Descriptor()
+}
+
+func (Proto2Message_Color) Type() protoreflect.EnumType {
+	return &file_internal_testpb_test2_proto_enumTypes[0]
+}
+
+func (x Proto2Message_Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Proto2Message_Color) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Proto2Message_Color(num)
+	return nil
+}
+
+// Deprecated: Use Proto2Message_Color.Descriptor instead.
+func (Proto2Message_Color) EnumDescriptor() ([]byte, []int) {
+	return file_internal_testpb_test2_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Proto2Message struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	BoolField    *bool          `protobuf:"varint,1,opt,name=bool_field,json=boolField" json:"bool_field,omitempty"`
+	StringField  *string        `protobuf:"bytes,2,opt,name=string_field,json=stringField" json:"string_field,omitempty"`
+	Int32Field   *int32         `protobuf:"varint,3,opt,name=int32_field,json=int32Field" json:"int32_field,omitempty"`
+	Int64Field   *int64         `protobuf:"varint,4,opt,name=int64_field,json=int64Field,def=64" json:"int64_field,omitempty"`
+	MessageField *Proto2Message `protobuf:"bytes,11,opt,name=message_field,json=messageField" json:"message_field,omitempty"`
+	BytesField   []byte         `protobuf:"bytes,12,opt,name=bytes_field,json=bytesField" json:"bytes_field,omitempty"`
+	// Deprecated: Marked as deprecated in internal/testpb/test2.proto.
+	DeprecatedField *int32               `protobuf:"varint,13,opt,name=deprecated_field,json=deprecatedField" json:"deprecated_field,omitempty"`
+	EnumField       *Proto2Message_Color `protobuf:"varint,14,opt,name=enum_field,json=enumField,enum=dev.bursavich.fieldmask.test.Proto2Message_Color" json:"enum_field,omitempty"`
+	// Types that are assignable to OneofField:
+	//	*Proto2Message_Int32OneofField
+	//	*Proto2Message_MessageOneofField
+	OneofField            isProto2Message_OneofField     `protobuf_oneof:"oneof_field"`
+	RepeatedInt32Field    []int32                        `protobuf:"varint,203,rep,name=repeated_int32_field,json=repeatedInt32Field" json:"repeated_int32_field,omitempty"`
+	RepeatedMessageField  []*Proto2Message               `protobuf:"bytes,211,rep,name=repeated_message_field,json=repeatedMessageField" json:"repeated_message_field,omitempty"`
+	RepeatedEnumField     []Proto2Message_Color          `protobuf:"varint,214,rep,name=repeated_enum_field,json=repeatedEnumField,enum=dev.bursavich.fieldmask.test.Proto2Message_Color" json:"repeated_enum_field,omitempty"`
+	MapStringStringField  map[string]string              `protobuf:"bytes,302,rep,name=map_string_string_field,json=mapStringStringField" json:"map_string_string_field,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringMessageField map[string]*Proto2Message      `protobuf:"bytes,502,rep,name=map_string_message_field,json=mapStringMessageField" json:"map_string_message_field,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MapStringEnumField    map[string]Proto2Message_Color `protobuf:"bytes,514,rep,name=map_string_enum_field,json=mapStringEnumField" json:"map_string_enum_field,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=dev.bursavich.fieldmask.test.Proto2Message_Color"`
+}
+
+// Default values for Proto2Message fields.
+const (
+	Default_Proto2Message_Int64Field = int64(64)
+)
+
+func (x *Proto2Message) Reset() {
+	*x = Proto2Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testpb_test2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2Message) ProtoMessage() {}
+
+func (x *Proto2Message) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_testpb_test2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2Message.ProtoReflect.Descriptor instead.
+func (*Proto2Message) Descriptor() ([]byte, []int) {
+	return file_internal_testpb_test2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Proto2Message) GetBoolField() bool {
+	if x != nil && x.BoolField != nil {
+		return *x.BoolField
+	}
+	return false
+}
+
+func (x *Proto2Message) GetStringField() string {
+	if x != nil && x.StringField != nil {
+		return *x.StringField
+	}
+	return ""
+}
+
+func (x *Proto2Message) GetInt32Field() int32 {
+	if x != nil && x.Int32Field != nil {
+		return *x.Int32Field
+	}
+	return 0
+}
+
+func (x *Proto2Message) GetInt64Field() int64 {
+	if x != nil && x.Int64Field != nil {
+		return *x.Int64Field
+	}
+	return Default_Proto2Message_Int64Field
+}
+
+func (x *Proto2Message) GetMessageField() *Proto2Message {
+	if x != nil {
+		return x.MessageField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetBytesField() []byte {
+	if x != nil {
+		return x.BytesField
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in internal/testpb/test2.proto.
+func (x *Proto2Message) GetDeprecatedField() int32 {
+	if x != nil && x.DeprecatedField != nil {
+		return *x.DeprecatedField
+	}
+	return 0
+}
+
+func (x *Proto2Message) GetEnumField() Proto2Message_Color {
+	if x != nil && x.EnumField != nil {
+		return *x.EnumField
+	}
+	return Proto2Message_COLOR_UNSPECIFIED
+}
+
+func (m *Proto2Message) GetOneofField() isProto2Message_OneofField {
+	if m != nil {
+		return m.OneofField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetInt32OneofField() int32 {
+	if x, ok := x.GetOneofField().(*Proto2Message_Int32OneofField); ok {
+		return x.Int32OneofField
+	}
+	return 0
+}
+
+func (x *Proto2Message) GetMessageOneofField() *Proto2Message {
+	if x, ok := x.GetOneofField().(*Proto2Message_MessageOneofField); ok {
+		return x.MessageOneofField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetRepeatedInt32Field() []int32 {
+	if x != nil {
+		return x.RepeatedInt32Field
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetRepeatedMessageField() []*Proto2Message {
+	if x != nil {
+		return x.RepeatedMessageField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetRepeatedEnumField() []Proto2Message_Color {
+	if x != nil {
+		return x.RepeatedEnumField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetMapStringStringField() map[string]string {
+	if x != nil {
+		return x.MapStringStringField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetMapStringMessageField() map[string]*Proto2Message {
+	if x != nil {
+		return x.MapStringMessageField
+	}
+	return nil
+}
+
+func (x *Proto2Message) GetMapStringEnumField() map[string]Proto2Message_Color {
+	if x != nil {
+		return x.MapStringEnumField
+	}
+	return nil
+}
+
+type isProto2Message_OneofField interface {
+	isProto2Message_OneofField()
+}
+
+type Proto2Message_Int32OneofField struct {
+	Int32OneofField int32 `protobuf:"varint,103,opt,name=int32_oneof_field,json=int32OneofField,oneof"`
+}
+
+type Proto2Message_MessageOneofField struct {
+	MessageOneofField *Proto2Message `protobuf:"bytes,111,opt,name=message_oneof_field,json=messageOneofField,oneof"`
+}
+
+func (*Proto2Message_Int32OneofField) isProto2Message_OneofField() {}
+
+func (*Proto2Message_MessageOneofField) isProto2Message_OneofField() {}
+
+type Proto2DefaultMaskMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32Field   *int32                    `protobuf:"varint,1,opt,name=int32_field,json=int32Field" json:"int32_field,omitempty"`
+	StringField  *string                   `protobuf:"bytes,2,opt,name=string_field,json=stringField" json:"string_field,omitempty"`
+	MessageField *Proto2DefaultMaskMessage `protobuf:"bytes,3,opt,name=message_field,json=messageField" json:"message_field,omitempty"`
+}
+
+func (x *Proto2DefaultMaskMessage) Reset() {
+	*x = Proto2DefaultMaskMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testpb_test2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2DefaultMaskMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2DefaultMaskMessage) ProtoMessage() {}
+
+func (x *Proto2DefaultMaskMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_testpb_test2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2DefaultMaskMessage.ProtoReflect.Descriptor instead.
+func (*Proto2DefaultMaskMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testpb_test2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Proto2DefaultMaskMessage) GetInt32Field() int32 {
+	if x != nil && x.Int32Field != nil {
+		return *x.Int32Field
+	}
+	return 0
+}
+
+func (x *Proto2DefaultMaskMessage) GetStringField() string {
+	if x != nil && x.StringField != nil {
+		return *x.StringField
+	}
+	return ""
+}
+
+func (x *Proto2DefaultMaskMessage) GetMessageField() *Proto2DefaultMaskMessage {
+	if x != nil {
+		return x.MessageField
+	}
+	return nil
+}
+
+type Proto2RequiredMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *int32                            `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name           *string                           `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Child          *Proto2RequiredMessage            `protobuf:"bytes,3,opt,name=child" json:"child,omitempty"`
+	Children       []*Proto2RequiredMessage          `protobuf:"bytes,4,rep,name=children" json:"children,omitempty"`
+	ChildrenByName map[string]*Proto2RequiredMessage `protobuf:"bytes,5,rep,name=children_by_name,json=childrenByName" json:"children_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Info           *Proto2Message                    `protobuf:"bytes,6,req,name=info" json:"info,omitempty"`
+}
+
+func (x *Proto2RequiredMessage) Reset() {
+	*x = Proto2RequiredMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testpb_test2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2RequiredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2RequiredMessage) ProtoMessage() {}
+
+func (x *Proto2RequiredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_testpb_test2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2RequiredMessage.ProtoReflect.Descriptor instead.
+func (*Proto2RequiredMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testpb_test2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Proto2RequiredMessage) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Proto2RequiredMessage) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Proto2RequiredMessage) GetChild() *Proto2RequiredMessage {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Proto2RequiredMessage) GetChildren() []*Proto2RequiredMessage {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Proto2RequiredMessage) GetChildrenByName() map[string]*Proto2RequiredMessage {
+	if x != nil {
+		return x.ChildrenByName
+	}
+	return nil
+}
+
+func (x *Proto2RequiredMessage) GetInfo() *Proto2Message {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type Proto2DeprecatedRequiredMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Marked as deprecated in internal/testpb/test2.proto.
+	A *int32 `protobuf:"varint,1,req,name=a" json:"a,omitempty"`
+	B *int32 `protobuf:"varint,2,opt,name=b" json:"b,omitempty"`
+}
+
+func (x *Proto2DeprecatedRequiredMessage) Reset() {
+	*x = Proto2DeprecatedRequiredMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_testpb_test2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2DeprecatedRequiredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2DeprecatedRequiredMessage) ProtoMessage() {}
+
+func (x *Proto2DeprecatedRequiredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_testpb_test2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2DeprecatedRequiredMessage.ProtoReflect.Descriptor instead.
+func (*Proto2DeprecatedRequiredMessage) Descriptor() ([]byte, []int) {
+	return file_internal_testpb_test2_proto_rawDescGZIP(), []int{3}
+}
+
+// Deprecated: Marked as deprecated in internal/testpb/test2.proto.
+func (x *Proto2DeprecatedRequiredMessage) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *Proto2DeprecatedRequiredMessage) GetB() int32 {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return 0
+}
+
+var file_internal_testpb_test2_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Proto2Message)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         1000,
+		Name:          "dev.bursavich.fieldmask.test.int32_ext",
+		Tag:           "varint,1000,opt,name=int32_ext",
+		Filename:      "internal/testpb/test2.proto",
+	},
+	{
+		ExtendedType:  (*Proto2Message)(nil),
+		ExtensionType: (*Proto2Message)(nil),
+		Field:         1011,
+		Name:          "dev.bursavich.fieldmask.test.message_ext",
+		Tag:           "bytes,1011,opt,name=message_ext",
+		Filename:      "internal/testpb/test2.proto",
+	},
+	{
+		ExtendedType:  (*Proto2Message)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         1202,
+		Name:          "dev.bursavich.fieldmask.test.repeated_string_ext",
+		Tag:           "bytes,1202,rep,name=repeated_string_ext",
+		Filename:      "internal/testpb/test2.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         50232,
+		Name:          "dev.bursavich.fieldmask.test.custom_default_mask",
+		Tag:           "bytes,50232,rep,name=custom_default_mask",
+		Filename:      "internal/testpb/test2.proto",
+	},
+}
+
+// Extension fields to Proto2Message.
+var (
+	// optional int32 int32_ext = 1000;
+	E_Int32Ext = &file_internal_testpb_test2_proto_extTypes[0]
+	// optional dev.bursavich.fieldmask.test.Proto2Message message_ext = 1011;
+	E_MessageExt = &file_internal_testpb_test2_proto_extTypes[1]
+	// repeated string repeated_string_ext = 1202;
+	E_RepeatedStringExt = &file_internal_testpb_test2_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// repeated string custom_default_mask = 50232;
+	E_CustomDefaultMask = &file_internal_testpb_test2_proto_extTypes[3]
+)
+
+var File_internal_testpb_test2_proto protoreflect.FileDescriptor
+
+var file_internal_testpb_test2_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x64,
+	0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d,
+	0x61, 0x73, 0x6b, 0x70, 0x62, 0x2f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb2, 0x0c, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x3a, 0x02, 0x36, 0x34, 0x52, 0x0a,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x50, 0x0a, 0x0d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2d, 0x0a,
+	0x10, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x50, 0x0a, 0x0a,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68,
+	0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5d, 0x0a, 0x13,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0xcb, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x62,
+	0x0a, 0x16, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd3, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x14, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x62, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0xd6, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68,
+	0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x75,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x7d, 0x0a, 0x17, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0xae, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x14, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0xf6, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x15, 0x6d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x77, 0x0a, 0x15, 0x6d, 0x61, 0x70, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x82, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d,
+	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x1a, 0x47, 0x0a, 0x19, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x75, 0x0a, 0x1a, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x78, 0x0a, 0x17, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x47,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x05, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x03, 0x2a, 0x06, 0x08, 0xe8, 0x07, 0x10, 0xd0,
+	0x0f, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4a, 0x04, 0x08, 0x14, 0x10, 0x17, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5b, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x3a, 0x3d, 0xba, 0xc3, 0x18, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0xba, 0xc3, 0x18, 0x1a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0xc2, 0xc3, 0x18, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x22, 0x83, 0x04, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x49, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x4f, 0x0a, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x71, 0x0a, 0x10,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72,
+	0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3f, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x1a, 0x76, 0x0a, 0x13, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x01, 0x61,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a,
+	0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x62, 0x3a, 0x49, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62,
+	0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x45, 0x78, 0x74, 0x3a, 0x7a, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73,
+	0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0xf3, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45,
+	0x78, 0x74, 0x3a, 0x5c, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x2e,
+	0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0xb2, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74,
+	0x3a, 0x51, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb8, 0x88, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d,
+	0x61, 0x73, 0x6b, 0x42, 0x29, 0x5a, 0x27, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63, 0x68,
+	0x2e, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+}
+
+var (
+	file_internal_testpb_test2_proto_rawDescOnce sync.Once
+	file_internal_testpb_test2_proto_rawDescData = file_internal_testpb_test2_proto_rawDesc
+)
+
+func file_internal_testpb_test2_proto_rawDescGZIP() []byte {
+	file_internal_testpb_test2_proto_rawDescOnce.Do(func() {
+		file_internal_testpb_test2_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_testpb_test2_proto_rawDescData)
+	})
+	return file_internal_testpb_test2_proto_rawDescData
+}
+
+var file_internal_testpb_test2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_testpb_test2_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_internal_testpb_test2_proto_goTypes = []interface{}{
+	(Proto2Message_Color)(0),                // 0: dev.bursavich.fieldmask.test.Proto2Message.Color
+	(*Proto2Message)(nil),                   // 1: dev.bursavich.fieldmask.test.Proto2Message
+	(*Proto2DefaultMaskMessage)(nil),        // 2: dev.bursavich.fieldmask.test.Proto2DefaultMaskMessage
+	(*Proto2RequiredMessage)(nil),           // 3: dev.bursavich.fieldmask.test.Proto2RequiredMessage
+	(*Proto2DeprecatedRequiredMessage)(nil), // 4: dev.bursavich.fieldmask.test.Proto2DeprecatedRequiredMessage
+	nil,                                     // 5: dev.bursavich.fieldmask.test.Proto2Message.MapStringStringFieldEntry
+	nil,                                     // 6: dev.bursavich.fieldmask.test.Proto2Message.MapStringMessageFieldEntry
+	nil,                                     // 7: dev.bursavich.fieldmask.test.Proto2Message.MapStringEnumFieldEntry
+	nil,                                     // 8: dev.bursavich.fieldmask.test.Proto2RequiredMessage.ChildrenByNameEntry
+	(*descriptorpb.MessageOptions)(nil),     // 9: google.protobuf.MessageOptions
+}
+var file_internal_testpb_test2_proto_depIdxs = []int32{
+	1,  // 0: dev.bursavich.fieldmask.test.Proto2Message.message_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	0,  // 1: dev.bursavich.fieldmask.test.Proto2Message.enum_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message.Color
+	1,  // 2: dev.bursavich.fieldmask.test.Proto2Message.message_oneof_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	1,  // 3: dev.bursavich.fieldmask.test.Proto2Message.repeated_message_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	0,  // 4: dev.bursavich.fieldmask.test.Proto2Message.repeated_enum_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message.Color
+	5,  // 5: dev.bursavich.fieldmask.test.Proto2Message.map_string_string_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message.MapStringStringFieldEntry
+	6,  // 6: dev.bursavich.fieldmask.test.Proto2Message.map_string_message_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message.MapStringMessageFieldEntry
+	7,  // 7: dev.bursavich.fieldmask.test.Proto2Message.map_string_enum_field:type_name -> dev.bursavich.fieldmask.test.Proto2Message.MapStringEnumFieldEntry
+	2,  // 8: dev.bursavich.fieldmask.test.Proto2DefaultMaskMessage.message_field:type_name -> dev.bursavich.fieldmask.test.Proto2DefaultMaskMessage
+	3,  // 9: dev.bursavich.fieldmask.test.Proto2RequiredMessage.child:type_name -> dev.bursavich.fieldmask.test.Proto2RequiredMessage
+	3,  // 10: dev.bursavich.fieldmask.test.Proto2RequiredMessage.children:type_name -> dev.bursavich.fieldmask.test.Proto2RequiredMessage
+	8,  // 11: dev.bursavich.fieldmask.test.Proto2RequiredMessage.children_by_name:type_name -> dev.bursavich.fieldmask.test.Proto2RequiredMessage.ChildrenByNameEntry
+	1,  // 12: dev.bursavich.fieldmask.test.Proto2RequiredMessage.info:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	1,  // 13: dev.bursavich.fieldmask.test.Proto2Message.MapStringMessageFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	0,  // 14: dev.bursavich.fieldmask.test.Proto2Message.MapStringEnumFieldEntry.value:type_name -> dev.bursavich.fieldmask.test.Proto2Message.Color
+	3,  // 15: dev.bursavich.fieldmask.test.Proto2RequiredMessage.ChildrenByNameEntry.value:type_name -> dev.bursavich.fieldmask.test.Proto2RequiredMessage
+	1,  // 16: dev.bursavich.fieldmask.test.int32_ext:extendee -> dev.bursavich.fieldmask.test.Proto2Message
+	1,  // 17: dev.bursavich.fieldmask.test.message_ext:extendee -> dev.bursavich.fieldmask.test.Proto2Message
+	1,  // 18: dev.bursavich.fieldmask.test.repeated_string_ext:extendee -> dev.bursavich.fieldmask.test.Proto2Message
+	9,  // 19: dev.bursavich.fieldmask.test.custom_default_mask:extendee -> google.protobuf.MessageOptions
+	1,  // 20: dev.bursavich.fieldmask.test.message_ext:type_name -> dev.bursavich.fieldmask.test.Proto2Message
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	20, // [20:21] is the sub-list for extension type_name
+	16, // [16:20] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_internal_testpb_test2_proto_init() }
+func file_internal_testpb_test2_proto_init() {
+	if File_internal_testpb_test2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_testpb_test2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testpb_test2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2DefaultMaskMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testpb_test2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2RequiredMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_testpb_test2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2DeprecatedRequiredMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_testpb_test2_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Proto2Message_Int32OneofField)(nil),
+		(*Proto2Message_MessageOneofField)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_testpb_test2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 4,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_testpb_test2_proto_goTypes,
+		DependencyIndexes: file_internal_testpb_test2_proto_depIdxs,
+		EnumInfos:         file_internal_testpb_test2_proto_enumTypes,
+		MessageInfos:      file_internal_testpb_test2_proto_msgTypes,
+		ExtensionInfos:    file_internal_testpb_test2_proto_extTypes,
+	}.Build()
+	File_internal_testpb_test2_proto = out.File
+	file_internal_testpb_test2_proto_rawDesc = nil
+	file_internal_testpb_test2_proto_goTypes = nil
+	file_internal_testpb_test2_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// LeafInfo describes a leaf path covered by a field mask.
+type LeafInfo struct {
+	// Path is the path of the leaf, as returned by Paths.
+	Path string
+	// Field is the descriptor of the last field in the path.
+	// If the leaf is a map key selection, it's the map field.
+	Field protoreflect.FieldDescriptor
+	// MapKey indicates if the leaf selects a key, or every key, of a map field.
+	MapKey bool
+	// ListWildcard indicates if the path descends through every element of a list field.
+	ListWildcard bool
+	// Scalar indicates if the values selected by the leaf are scalars rather than messages.
+	// A scalar list field or a map key selection with scalar values is a scalar leaf.
+	Scalar bool
+}
+
+// Leaves returns a description of each leaf path covered by the field mask.
+// It's a structured version of Paths, except that a complete field mask
+// returns a leaf for each field of the message rather than a wildcard.
+func (fm *FieldMask[T]) Leaves() []LeafInfo {
+	paths := fm.msg.paths()
+	if len(paths) == 0 {
+		return fm.fieldLeaves()
+	}
+	aliases := fm.aliasPaths(slices.Clone(paths))
+	leaves := make([]LeafInfo, len(paths))
+	for i, path := range paths {
+		leaf, err := fm.resolveLeaf(path)
+		if err != nil {
+			panic(fmt.Sprintf("fieldmask: internal error: failed to resolve path: %q: %v", path, err))
+		}
+		leaf.Path = aliases[i]
+		leaves[i] = leaf
+	}
+	return leaves
+}
+
+// fieldLeaves returns a leaf for each allowed field of the root message.
+func (s *settings) fieldLeaves() []LeafInfo {
+	fields := s.rootDesc.Fields()
+	leaves := make([]LeafInfo, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !s.allow(fd) {
+			continue
+		}
+		leaves = append(leaves, LeafInfo{
+			Path:   s.fieldKey(fd),
+			Field:  fd,
+			Scalar: !isMessage(fd.Kind()),
+		})
+	}
+	return leaves
+}
+
+// resolveLeaf resolves the descriptors named by the path from the root.
+func (s *settings) resolveLeaf(path string) (LeafInfo, error) {
+	var leaf LeafInfo
+	desc := s.rootDesc
+	path = strings.TrimSuffix(path, string(s.pathSep))
+	for {
+		name, subpath, err := nextSegment(s.expandStructPath(desc, path), s.pathSep)
+		if err != nil {
+			return leaf, err
+		}
+		name, subpath, _ = splitListSlice(name, subpath, s.pathSep)
+		if typeName, ok := anyTypeName(name); ok && desc.FullName() == anyFullName {
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(typeName)
+			if err != nil {
+				return leaf, fmt.Errorf("unknown %v packed type: %q", desc.FullName(), typeName)
+			}
+			if subpath == "" {
+				return leaf, nil
+			}
+			desc, path = mt.Descriptor(), subpath
+			continue
+		}
+		_, fd, ok := s.lookupMessageField(desc, desc.Fields(), name)
+		if !ok {
+			return leaf, s.unknownFieldError(desc, name)
+		}
+		leaf.Field, leaf.MapKey = fd, false
+		leaf.Scalar = !isMessage(fd.Kind())
+		if subpath != "" && (fd.IsList() || fd.IsMap()) {
+			key, rest, err := nextSegment(subpath, s.pathSep)
+			if err != nil {
+				return leaf, err
+			}
+			if fd.IsMap() {
+				leaf.MapKey = true
+				leaf.Scalar = !isMessage(fd.MapValue().Kind())
+			} else if key == "*" {
+				leaf.ListWildcard = true
+			}
+			subpath = rest
+		}
+		if subpath == "" {
+			return leaf, nil
+		}
+		if fd.IsMap() {
+			desc = fd.MapValue().Message()
+		} else {
+			desc = fd.Message()
+		}
+		if desc == nil {
+			return leaf, fmt.Errorf("invalid scalar field subpath: %q", subpath)
+		}
+		path = subpath
+	}
+}
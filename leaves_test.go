@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestLeaves(t *testing.T) {
+	type leaf struct {
+		Path         string
+		Field        protoreflect.FullName
+		MapKey       bool
+		ListWildcard bool
+		Scalar       bool
+	}
+	tests := []struct {
+		name string
+		mask string
+		opts []Option
+		want []leaf
+	}{
+		{
+			name: "fields",
+			mask: "int32_field,message_field,message_field.message_field.string_field,repeated_string_field",
+			want: []leaf{
+				{Path: "int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", Scalar: true},
+				{Path: "message_field", Field: "dev.bursavich.fieldmask.test.Message.message_field"},
+				{Path: "repeated_string_field", Field: "dev.bursavich.fieldmask.test.Message.repeated_string_field", Scalar: true},
+			},
+		},
+		{
+			name: "nested",
+			mask: "message_field.message_field.string_field",
+			want: []leaf{
+				{Path: "message_field.message_field.string_field", Field: "dev.bursavich.fieldmask.test.Message.string_field", Scalar: true},
+			},
+		},
+		{
+			name: "lists",
+			mask: "repeated_message_field.*.int32_field,repeated_message_field.1",
+			want: []leaf{
+				{Path: "repeated_message_field.*.int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", ListWildcard: true, Scalar: true},
+				{Path: "repeated_message_field.1", Field: "dev.bursavich.fieldmask.test.Message.repeated_message_field"},
+			},
+		},
+		{
+			name: "slices",
+			mask: "repeated_int32_field[-3:],repeated_message_field[:2].int32_field",
+			want: []leaf{
+				{Path: "repeated_int32_field[-3:]", Field: "dev.bursavich.fieldmask.test.Message.repeated_int32_field", Scalar: true},
+				{Path: "repeated_message_field[:2].int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", Scalar: true},
+			},
+		},
+		{
+			name: "any",
+			mask: "repeated_any_field.*.(dev.bursavich.fieldmask.test.Message).int32_field",
+			want: []leaf{
+				{Path: "repeated_any_field.*.(dev.bursavich.fieldmask.test.Message).int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", ListWildcard: true, Scalar: true},
+			},
+		},
+		{
+			name: "maps",
+			mask: "map_string_string_field.a,map_string_message_field.*,map_string_message_field.b.int32_field",
+			want: []leaf{
+				{Path: "map_string_message_field", Field: "dev.bursavich.fieldmask.test.Message.map_string_message_field"},
+				{Path: "map_string_string_field.a", Field: "dev.bursavich.fieldmask.test.Message.map_string_string_field", MapKey: true, Scalar: true},
+			},
+		},
+		{
+			name: "keyed",
+			mask: "map_string_message_field.b.int32_field",
+			want: []leaf{
+				{Path: "map_string_message_field.b.int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", Scalar: true},
+			},
+		},
+		{
+			name: "struct",
+			mask: "struct_field.labels.env,list_value_field.*.name",
+			want: []leaf{
+				{Path: "list_value_field.*.name", Field: "google.protobuf.Struct.fields", MapKey: true, ListWildcard: true},
+				{Path: "struct_field.labels.env", Field: "google.protobuf.Struct.fields", MapKey: true},
+			},
+		},
+		{
+			name: "aliases",
+			mask: "alias.int32_field",
+			opts: []Option{WithAlias(map[string]string{"alias": "message_field"})},
+			want: []leaf{
+				{Path: "alias.int32_field", Field: "dev.bursavich.fieldmask.test.Message.int32_field", Scalar: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			var got []leaf
+			for _, l := range fm.Leaves() {
+				got = append(got, leaf{
+					Path:         l.Path,
+					Field:        l.Field.FullName(),
+					MapKey:       l.MapKey,
+					ListWildcard: l.ListWildcard,
+					Scalar:       l.Scalar,
+				})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected leaves (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	fm, err := Parse[*testpb.Message]("*")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	leaves := fm.Leaves()
+	if n := (&testpb.Message{}).ProtoReflect().Descriptor().Fields().Len(); len(leaves) != n {
+		t.Errorf("Unexpected number of complete leaves: got: %d; want: %d", len(leaves), n)
+	}
+}
@@ -8,7 +8,11 @@ package fieldmask
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -24,59 +28,116 @@ var _ fieldMask = (*scalarListFieldMask)(nil)
 
 type scalarListFieldMask struct {
 	desc     protoreflect.FieldDescriptor
+	slice    *listSlice
 	settings *settings
 }
 
-func (fm *scalarListFieldMask) complete() bool { return true }
+func (fm *scalarListFieldMask) complete() bool { return fm.slice == nil }
 
 func (fm *scalarListFieldMask) init(path string) error {
-	return fm.add(path)
+	return fm.add(path, false)
 }
 
 func (fm *scalarListFieldMask) append(path string) error {
-	return fm.add(path)
+	return fm.add(path, fm.complete())
 }
 
-func (fm *scalarListFieldMask) add(path string) error {
+func (fm *scalarListFieldMask) add(path string, complete bool) error {
+	if path != "" && fm.settings.rejectScalarListSubpaths {
+		return fmt.Errorf("invalid scalar list subpath: %q: the whole field must be selected", path)
+	}
 	if path == "" || path == "*" {
+		fm.slice = nil
 		return nil
 	}
-	token, subpath, err := nextSegment(path)
+	token, subpath, err := nextSegment(path, fm.settings.pathSep)
 	if err != nil {
 		return err
 	}
-	if token != "*" {
+	slice, isSlice := parseListSlice(token)
+	if token != "*" && !isSlice {
 		return fmt.Errorf("invalid list path: %q", path)
 	}
 	if subpath != "" {
 		return fmt.Errorf("invalid scalar field subpath: %q", subpath)
 	}
+	switch {
+	case token == "*":
+		fm.slice = nil
+	case complete:
+		// The whole list is already covered.
+	case fm.slice != nil && *fm.slice != slice:
+		return fmt.Errorf("conflicting list slices: %v and %v", fm.slice, slice)
+	default:
+		fm.slice = &slice
+	}
 	return nil
 }
 
 func (fm *scalarListFieldMask) paths() []string {
+	if fm.slice != nil {
+		return []string{fm.slice.String()}
+	}
 	return nil
 }
 
-func (fm *scalarListFieldMask) mask(parent protoreflect.Message, value protoreflect.Value) {}
+func (fm *scalarListFieldMask) coversPath(path string) bool {
+	if fm.complete() {
+		return true
+	}
+	token, subpath, err := nextSegment(path, fm.settings.pathSep)
+	if err != nil || subpath != "" {
+		return false
+	}
+	slice, ok := parseListSlice(token)
+	return ok && *fm.slice == slice
+}
+
+func (fm *scalarListFieldMask) mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState) {
+	if fm.slice != nil {
+		list := value.List()
+		start, end := fm.slice.bounds(list.Len())
+		fm.settings.sliceList(list, fm.desc, start, end, path, nil)
+	}
+	fm.settings.filterScalarList(value.List(), fm.desc)
+}
 
-func (fm *scalarListFieldMask) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
+func (fm *scalarListFieldMask) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
 	src := value.List()
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(src.Len())
+		src = subList{List: src, start: start, end: end}
+	}
 	dst := parent.NewField(fm.desc).List()
-	fm.settings.copyList(dst, src, fm.desc)
+	fm.settings.copyList(dst, src, fm.desc, c)
 	return protoreflect.ValueOfList(dst)
 }
 
-func (fm *scalarListFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
+func (fm *scalarListFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
+	if fm.slice != nil {
+		var src protoreflect.List
+		if exists && value.IsValid() && value.List().IsValid() {
+			src = value.List()
+		}
+		fm.settings.updateSlice(parent, fm.desc, src, *fm.slice, func(v protoreflect.Value) protoreflect.Value {
+			if fm.desc.Kind() == protoreflect.BytesKind {
+				return cloneBytesValue(v)
+			}
+			return v
+		}, c)
+		return
+	}
 	if !exists || !value.IsValid() || !value.List().IsValid() {
-		if fm.settings.updateRepeated == UpdateReplacesRepeated {
+		if c.updates(fm.settings).updateRepeated == UpdateReplacesRepeated {
 			parent.Clear(fm.desc)
 		}
 		return
 	}
 
-	switch fm.settings.updateRepeated {
-	case UpdateAppendsRepeated:
+	switch {
+	case fm.settings.leafMerger != nil:
+		fm.settings.updateList(parent.Mutable(fm.desc).List(), value.List(), fm.desc, c)
+	case c.updates(fm.settings).updateRepeated == UpdateAppendsRepeated:
 		src := value.List()
 		dst := parent.Mutable(fm.desc).List()
 		for i, n := 0, src.Len(); i < n; i++ {
@@ -85,121 +146,437 @@ func (fm *scalarListFieldMask) update(parent protoreflect.Message, value protore
 	default: // UpdateReplacesRepeated
 		parent.Set(fm.desc, value)
 	}
+	fm.settings.clearEmptyList(parent, fm.desc, c)
+}
+
+func (fm *scalarListFieldMask) modifies(value protoreflect.Value) bool {
+	list := value.List()
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(list.Len())
+		if end-start < list.Len() {
+			return true
+		}
+	}
+	return fm.settings.filtersScalarList(list, fm.desc)
+}
+
+func (fm *scalarListFieldMask) covers(value protoreflect.Value, path string) bool {
+	if path == "" {
+		return true
+	}
+	i, subpath, ok := listIndex(path, fm.settings.pathSep)
+	if !ok || subpath != "" || i >= value.List().Len() {
+		return false
+	}
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(value.List().Len())
+		return start <= i && i < end
+	}
+	return true
 }
 
 var _ fieldMask = (*msgListFieldMask)(nil)
 
+// A msgListFieldMask masks the elements of a message list with a wild mask that applies
+// to every element and indexed masks that apply to the elements at specific indices.
+// An indexed mask includes the paths of the wild mask. If there's a slice, the wild mask,
+// if any, applies to every element it selects and there are no indexed masks.
 type msgListFieldMask struct {
-	desc     protoreflect.FieldDescriptor
-	msgMask  *msgMask
-	settings *settings
+	desc         protoreflect.FieldDescriptor
+	wildMask     *msgMask
+	indexedMasks map[int]*msgMask
+	slice        *listSlice
+	settings     *settings
 }
 
-func (fm *msgListFieldMask) complete() bool { return fm.msgMask == nil }
+func (fm *msgListFieldMask) complete() bool {
+	return fm.wildMask == nil && fm.indexedMasks == nil && fm.slice == nil
+}
 
 func (fm *msgListFieldMask) init(path string) error {
+	return fm.add(path, false)
+}
+
+func (fm *msgListFieldMask) append(path string) error {
+	return fm.add(path, fm.complete())
+}
+
+func (fm *msgListFieldMask) add(path string, complete bool) error {
 	if path == "" || path == "*" {
-		fm.msgMask = nil
-		return nil
+		return fm.addWild("")
 	}
-	token, subpath, err := nextSegment(path)
+	token, subpath, err := nextSegment(path, fm.settings.pathSep)
 	if err != nil {
 		return err
 	}
+	if slice, ok := parseListSlice(token); ok {
+		if complete {
+			// TODO: Validate the subpath.
+			return nil
+		}
+		return fm.addSlice(slice, subpath)
+	}
+	if fm.slice != nil {
+		return fmt.Errorf("invalid list path: %q: can't be combined with list slice %v", path, fm.slice)
+	}
+	i := -1
 	if token != "*" {
-		return fmt.Errorf("invalid list path: %q", path)
+		i, err = strconv.Atoi(token)
+		if err != nil || i < 0 || strconv.Itoa(i) != token {
+			return fmt.Errorf("invalid list path: %q", path)
+		}
 	}
-	vm := newMsgMask(fm.settings, fm.desc.Message())
-	if err := vm.init(subpath); err != nil {
+	switch {
+	case complete:
+		// TODO: Validate the subpath.
+		return nil
+	case i < 0:
+		return fm.addWild(subpath)
+	default:
+		return fm.addIndexed(i, subpath)
+	}
+}
+
+func (fm *msgListFieldMask) addWild(subpath string) error {
+	if subpath == "" {
+		fm.wildMask = nil
+		fm.indexedMasks = nil
+		fm.slice = nil
+		return nil
+	}
+	if fm.wildMask == nil {
+		m := newMsgMask(fm.settings, fm.desc.Message())
+		if err := m.init(subpath); err != nil {
+			return err
+		}
+		fm.wildMask = m
+	} else if err := fm.wildMask.append(subpath); err != nil {
 		return err
 	}
-	fm.msgMask = vm
+	for _, m := range fm.indexedMasks {
+		if err := m.append(subpath); err != nil {
+			panic(fmt.Sprintf("fieldmask: internal error: successful wild mask append failed on indexed mask: %q: %v", subpath, err))
+		}
+	}
 	return nil
 }
 
-func (fm *msgListFieldMask) append(path string) error {
-	if path == "" || path == "*" {
-		fm.msgMask = nil
-		return nil
+func (fm *msgListFieldMask) addSlice(slice listSlice, subpath string) error {
+	// A slice can only be combined with the same slice or with paths that cover the whole list.
+	switch {
+	case fm.slice == nil && (fm.wildMask != nil || fm.indexedMasks != nil):
+		return fmt.Errorf("invalid list slice %v: can't be combined with other list paths", slice)
+	case fm.slice != nil && *fm.slice != slice:
+		return fmt.Errorf("conflicting list slices: %v and %v", fm.slice, slice)
+	case fm.slice == nil && subpath != "":
+		m := newMsgMask(fm.settings, fm.desc.Message())
+		if err := m.init(subpath); err != nil {
+			return err
+		}
+		fm.wildMask = m
+	case subpath == "":
+		fm.wildMask = nil
+	case fm.wildMask != nil:
+		if err := fm.wildMask.append(subpath); err != nil {
+			return err
+		}
 	}
-	token, subpath, err := nextSegment(path)
-	if err != nil {
+	fm.slice = &slice
+	return nil
+}
+
+// sliceMask returns the mask of the elements selected by the slice.
+func (fm *msgListFieldMask) sliceMask() *msgMask {
+	if fm.wildMask != nil {
+		return fm.wildMask
+	}
+	return newMsgMask(fm.settings, fm.desc.Message())
+}
+
+func (fm *msgListFieldMask) addIndexed(i int, subpath string) error {
+	if m, ok := fm.indexedMasks[i]; ok {
+		return m.append(subpath)
+	}
+	m := newMsgMask(fm.settings, fm.desc.Message())
+	if err := m.init(subpath); err != nil {
 		return err
 	}
-	if token != "*" {
-		return fmt.Errorf("invalid list path: %q", path)
+	if fm.wildMask != nil {
+		for _, path := range fm.wildMask.paths() {
+			if err := m.append(path); err != nil {
+				panic(fmt.Sprintf("fieldmask: internal error: successful wild mask append failed on indexed mask: %q: %v", subpath, err))
+			}
+		}
 	}
-	if fm.msgMask == nil {
-		// TODO: Validate the subpath.
-		return nil
+	if fm.indexedMasks == nil {
+		fm.indexedMasks = make(map[int]*msgMask)
 	}
-	return fm.msgMask.append(subpath)
+	fm.indexedMasks[i] = m
+	return nil
 }
 
 func (fm *msgListFieldMask) paths() []string {
-	if fm.complete() {
-		return nil
+	if fm.slice != nil {
+		name := fm.slice.String()
+		if fm.wildMask == nil {
+			return []string{name}
+		}
+		var paths []string
+		for _, sub := range fm.wildMask.paths() {
+			paths = append(paths, joinPath(name, sub, fm.settings.pathSep))
+		}
+		return paths
+	}
+	var wild []string
+	var paths []string
+	if fm.wildMask != nil {
+		wild = fm.wildMask.paths()
+		for _, sub := range wild {
+			paths = append(paths, joinPath("*", sub, fm.settings.pathSep))
+		}
 	}
-	subs := fm.msgMask.paths()
-	paths := make([]string, len(subs))
-	for i, sub := range subs {
-		paths[i] = joinPath("*", sub)
+	if fm.indexedMasks == nil {
+		return paths
+	}
+	needles := toSet(wild)
+	indices := maps.Keys(fm.indexedMasks)
+	slices.Sort(indices)
+	for _, i := range indices {
+		name := strconv.Itoa(i)
+		subs := fm.indexedMasks[i].paths()
+		if len(subs) == 0 {
+			paths = append(paths, name)
+			continue
+		}
+		for _, sub := range remove(subs, needles) {
+			paths = append(paths, joinPath(name, sub, fm.settings.pathSep))
+		}
 	}
 	return paths
 }
 
-func (fm *msgListFieldMask) mask(parent protoreflect.Message, value protoreflect.Value) {
-	if fm.msgMask == nil {
+func (fm *msgListFieldMask) coversPath(path string) bool {
+	if fm.complete() {
+		return true
+	}
+	if path == "" || path == "*" {
+		return false
+	}
+	token, subpath, err := nextSegment(path, fm.settings.pathSep)
+	if err != nil {
+		return false
+	}
+	slice, isSlice := parseListSlice(token)
+	if fm.slice != nil {
+		return isSlice && *fm.slice == slice && (fm.wildMask == nil || fm.wildMask.coversPath(subpath))
+	}
+	if !isSlice && token != "*" {
+		i, subpath, ok := listIndex(path, fm.settings.pathSep)
+		if !ok {
+			return false
+		}
+		if m, ok := fm.indexedMasks[i]; ok {
+			return m.coversPath(subpath)
+		}
+	}
+	// The wild mask selects the same subpath of every element.
+	return fm.wildMask != nil && fm.wildMask.coversPath(subpath)
+}
+
+func (fm *msgListFieldMask) lookupMask(i int) (*msgMask, bool) {
+	if m, ok := fm.indexedMasks[i]; ok {
+		return m, true
+	}
+	if fm.wildMask != nil {
+		return fm.wildMask, true
+	}
+	return nil, false
+}
+
+// indexedLen returns the length of the prefix of a list with the given length
+// that's covered by the mask. Elements beyond the highest index in the list
+// are dropped unless there's a wild mask.
+func (fm *msgListFieldMask) indexedLen(n int) int {
+	if fm.wildMask != nil {
+		return n
+	}
+	last := -1
+	for i := range fm.indexedMasks {
+		if i < n {
+			last = max(last, i)
+		}
+	}
+	return last + 1
+}
+
+func (fm *msgListFieldMask) mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState) {
+	if fm.complete() {
+		fm.settings.filterList(value.List(), fm.desc, path, c)
 		return
 	}
 	list := value.List()
-	for i, n := 0, list.Len(); i < n; i++ {
-		fm.msgMask.mask(list.Get(i).Message())
+	d := fm.settings.distinctSet(fm.desc)
+	var drop []bool
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(list.Len())
+		m := fm.sliceMask()
+		if d != nil {
+			drop = make([]bool, end-start)
+		}
+		fm.settings.sliceList(list, fm.desc, start, end, path, func(i int, v protoreflect.Value) {
+			if !d.first(v.Message()) {
+				drop[i-start] = true
+				return
+			}
+			m.mask(v.Message(), fm.settings.listIndexPath(path, i), c)
+		})
+		if drop != nil {
+			fm.settings.removeElements(list, fm.desc, drop, path, start)
+		}
+		return
+	}
+	n := fm.indexedLen(list.Len())
+	if d != nil {
+		drop = make([]bool, n)
+	}
+	for i := 0; i < n; i++ {
+		msg := list.Get(i).Message()
+		if !d.first(msg) {
+			drop[i] = true
+			continue
+		}
+		if m, ok := fm.lookupMask(i); ok {
+			m.mask(msg, fm.settings.listIndexPath(path, i), c)
+			continue
+		}
+		// Clear the element but leave it in place so that the indices of other elements are unchanged.
+		clearMessage(msg)
+		fm.settings.cleared(path, strconv.Itoa(i), fm.desc)
+	}
+	for i := n; i < list.Len(); i++ {
+		fm.settings.cleared(path, strconv.Itoa(i), fm.desc)
+	}
+	list.Truncate(n)
+	if drop != nil {
+		fm.settings.removeElements(list, fm.desc, drop, path, 0)
 	}
 }
 
-func (fm *msgListFieldMask) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
+func (fm *msgListFieldMask) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
 	src := value.List()
 	dst := parent.NewField(fm.desc).List()
-	if fm.msgMask == nil {
-		fm.settings.copyList(dst, src, fm.desc)
+	if fm.complete() {
+		fm.settings.copyList(dst, src, fm.desc, c)
 		return protoreflect.ValueOfList(dst)
 	}
-	for i, n := 0, src.Len(); i < n; i++ {
-		msg := src.Get(i).Message()
-		clone := fm.msgMask.clone(msg)
-		dst.Append(protoreflect.ValueOfMessage(clone))
+	d := fm.settings.distinctSet(fm.desc)
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(src.Len())
+		m := fm.sliceMask()
+		for i := start; i < end; i++ {
+			if !d.first(src.Get(i).Message()) {
+				continue
+			}
+			dst.Append(protoreflect.ValueOfMessage(m.clone(src.Get(i).Message(), c)))
+		}
+		if fm.settings.cloneDedupRepeated {
+			dedupList(dst, fm.desc)
+		}
+		return protoreflect.ValueOfList(dst)
+	}
+	for i, n := 0, fm.indexedLen(src.Len()); i < n; i++ {
+		if !d.first(src.Get(i).Message()) {
+			continue
+		}
+		if m, ok := fm.lookupMask(i); ok {
+			clone := m.clone(src.Get(i).Message(), c)
+			dst.Append(protoreflect.ValueOfMessage(clone))
+		} else {
+			dst.Append(dst.NewElement())
+		}
+	}
+	if fm.settings.cloneDedupRepeated {
+		dedupList(dst, fm.desc)
 	}
 	return protoreflect.ValueOfList(dst)
 }
 
-func (fm *msgListFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
+func (fm *msgListFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
+	if fm.slice != nil {
+		var src protoreflect.List
+		if exists && value.IsValid() && value.List().IsValid() {
+			src = value.List()
+		}
+		m := fm.sliceMask()
+		fm.settings.updateSlice(parent, fm.desc, src, *fm.slice, func(v protoreflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfMessage(m.clone(v.Message(), c))
+		}, c)
+		return
+	}
+	if fm.wildMask == nil && fm.indexedMasks != nil {
+		fm.updateIndexed(parent, value, exists, c)
+		return
+	}
 	if !exists || !value.IsValid() || !value.List().IsValid() {
-		if fm.settings.updateRepeated == UpdateReplacesRepeated {
+		if c.updates(fm.settings).updateRepeated == UpdateReplacesRepeated {
 			parent.Clear(fm.desc)
 		}
 		return
 	}
 
 	if fm.complete() {
-		fm.updateComplete(parent, value)
+		fm.updateComplete(parent, value, c)
 		return
 	}
 	src := value.List()
 	dst := parent.Mutable(fm.desc).List()
-	if fm.settings.updateRepeated == UpdateReplacesRepeated {
+	if c.updates(fm.settings).updateRepeated == UpdateReplacesRepeated {
 		dst.Truncate(0)
 	}
 	for i, n := 0, src.Len(); i < n; i++ {
 		// TODO: This doesn't necessarily require a clone.
-		msg := src.Get(i).Message()
-		clone := fm.msgMask.clone(msg)
+		m, _ := fm.lookupMask(i)
+		clone := m.clone(src.Get(i).Message(), c)
 		dst.Append(protoreflect.ValueOfMessage(clone))
 	}
+	fm.settings.clearEmptyList(parent, fm.desc, c)
+}
+
+// updateIndexed updates the elements of the destination list at the indices of the indexed masks
+// with the elements of the source list at the same indices, regardless of the UpdateRepeated mode.
+// Other elements are left untouched. If the source list has no element at an index, the masked
+// fields of the destination element are cleared. If the destination list has no element at an index
+// but the source list does, the destination list is extended with empty elements up to the index.
+func (fm *msgListFieldMask) updateIndexed(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
+	var src protoreflect.List
+	if exists && value.IsValid() && value.List().IsValid() {
+		src = value.List()
+	} else if !parent.Has(fm.desc) {
+		return // Nothing to clear
+	}
+	zero := parent.NewField(fm.desc).List().NewElement().Message().Type().Zero()
+	dst := parent.Mutable(fm.desc).List()
+	indices := maps.Keys(fm.indexedMasks)
+	slices.Sort(indices)
+	for _, i := range indices {
+		srcMsg := zero
+		if src != nil && i < src.Len() {
+			srcMsg = src.Get(i).Message()
+		} else if i >= dst.Len() {
+			continue // Nothing to clear
+		}
+		for dst.Len() <= i {
+			dst.Append(dst.NewElement())
+		}
+		dstMsg := dst.Get(i).Message()
+		fm.indexedMasks[i].update(dstMsg, srcMsg, c)
+		dst.Set(i, protoreflect.ValueOfMessage(dstMsg))
+	}
+	fm.settings.clearEmptyList(parent, fm.desc, c)
 }
 
-func (fm *msgListFieldMask) updateComplete(parent protoreflect.Message, value protoreflect.Value) {
-	switch fm.settings.updateRepeated {
+func (fm *msgListFieldMask) updateComplete(parent protoreflect.Message, value protoreflect.Value, c *callState) {
+	switch c.updates(fm.settings).updateRepeated {
 	case UpdateAppendsRepeated:
 		src := value.List()
 		dst := parent.Mutable(fm.desc).List()
@@ -209,8 +586,90 @@ func (fm *msgListFieldMask) updateComplete(parent protoreflect.Message, value pr
 	default: // UpdateReplacesRepeated
 		parent.Set(fm.desc, value)
 	}
+	fm.settings.clearEmptyList(parent, fm.desc, c)
+}
+
+func (fm *msgListFieldMask) covers(value protoreflect.Value, path string) bool {
+	if path == "" {
+		return true
+	}
+	i, subpath, ok := listIndex(path, fm.settings.pathSep)
+	if !ok || i >= value.List().Len() {
+		return false
+	}
+	elem := value.List().Get(i).Message()
+	if fm.complete() {
+		return newMsgMask(fm.settings, fm.desc.Message()).covers(elem, subpath)
+	}
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(value.List().Len())
+		return start <= i && i < end && fm.sliceMask().covers(elem, subpath)
+	}
+	m, ok := fm.lookupMask(i)
+	return ok && m.covers(elem, subpath)
+}
+
+func (fm *msgListFieldMask) modifies(value protoreflect.Value) bool {
+	if fm.complete() {
+		return fm.settings.filtersList(value.List(), fm.desc)
+	}
+	list := value.List()
+	if fm.slice != nil {
+		start, end := fm.slice.bounds(list.Len())
+		if end-start < list.Len() {
+			return true
+		}
+		m, d := fm.sliceMask(), fm.settings.distinctSet(fm.desc)
+		for i := start; i < end; i++ {
+			if msg := list.Get(i).Message(); !d.first(msg) || m.modifies(msg) {
+				return true
+			}
+		}
+		return false
+	}
+	n := fm.indexedLen(list.Len())
+	if n < list.Len() {
+		return true
+	}
+	d := fm.settings.distinctSet(fm.desc)
+	for i := 0; i < n; i++ {
+		msg := list.Get(i).Message()
+		if !d.first(msg) {
+			return true
+		}
+		if m, ok := fm.lookupMask(i); ok {
+			if m.modifies(msg) {
+				return true
+			}
+		} else if proto.Size(msg.Interface()) > 0 {
+			return true // An uncovered element is cleared.
+		}
+	}
+	return false
+}
+
+// listIndex parses the next segment of the path as a list index.
+func listIndex(path string, sep rune) (i int, subpath string, ok bool) {
+	token, subpath, err := nextSegment(path, sep)
+	if err != nil {
+		return 0, "", false
+	}
+	i, err = strconv.Atoi(token)
+	if err != nil || i < 0 || strconv.Itoa(i) != token {
+		return 0, "", false
+	}
+	return i, subpath, true
 }
 
 func isMessage(k protoreflect.Kind) bool {
 	return k == protoreflect.MessageKind || k == protoreflect.GroupKind
 }
+
+// clearMessage clears all of the fields of the message, including unknown fields.
+func clearMessage(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		msg.Clear(fd)
+		return true
+	})
+	msg.SetUnknown(nil)
+}
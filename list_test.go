@@ -328,10 +328,12 @@ func (tt *listTest) runMessage(t *testing.T) {
 		err:  true,
 	}.run(t)
 
-	basicTest{
-		mask: tt.field + ".0.int32_field",
-		err:  true,
-	}.run(t)
+	for _, index := range []string{"-1", "01", "+1", "x"} {
+		basicTest{
+			mask: tt.field + "." + index + ".int32_field",
+			err:  true,
+		}.run(t)
+	}
 
 	basicTest{
 		mask:  tt.field + ".*.int32_field",
@@ -411,3 +413,470 @@ func (tt *listTest) runMessage(t *testing.T) {
 		}(),
 	}.run(t)
 }
+
+func TestCloneDedupRepeated(t *testing.T) {
+	msg := &testpb.Message{
+		RepeatedStringField: []string{"b", "a", "b", "c", "a"},
+		RepeatedBytesField:  [][]byte{[]byte("x"), []byte("y"), []byte("x")},
+		RepeatedMessageField: []*testpb.Message{
+			simpleMsg(1, "a"),
+			simpleMsg(2, "b"),
+			simpleMsg(1, "a"),
+			simpleMsg(1, "c"),
+		},
+	}
+	tests := []struct {
+		mask string
+		out  *testpb.Message
+	}{
+		{
+			mask: "repeated_string_field,repeated_bytes_field,repeated_message_field",
+			out: &testpb.Message{
+				RepeatedStringField: []string{"b", "a", "c"},
+				RepeatedBytesField:  [][]byte{[]byte("x"), []byte("y")},
+				RepeatedMessageField: []*testpb.Message{
+					simpleMsg(1, "a"),
+					simpleMsg(2, "b"),
+					simpleMsg(1, "c"),
+				},
+			},
+		},
+		{
+			mask: "repeated_message_field.*.int32_field",
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{
+					{Int32Field: 1},
+					{Int32Field: 2},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, WithCloneDedupRepeated(true))
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			if diff := protoDiff(tt.out, fm.Clone(msg)); diff != "" {
+				t.Fatalf("Clone: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepeatedDistinctBy(t *testing.T) {
+	distinct := WithRepeatedDistinctBy("repeated_message_field", "string_field")
+	list := []*testpb.Message{
+		simpleMsg(1, "a"),
+		simpleMsg(2, "b"),
+		simpleMsg(3, "a"),
+		simpleMsg(4, ""),
+		simpleMsg(5, ""),
+	}
+	msg := &testpb.Message{
+		RepeatedMessageField: list,
+		MessageField:         &testpb.Message{RepeatedMessageField: list},
+	}
+	for _, tt := range []basicTest{
+		{
+			mask:  "repeated_message_field",
+			paths: []string{"repeated_message_field"},
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{list[0], list[1], list[3]},
+			},
+		},
+		{
+			name:  "subfield masked out",
+			mask:  "repeated_message_field.*.int32_field",
+			paths: []string{"repeated_message_field.*.int32_field"},
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{{Int32Field: 1}, {Int32Field: 2}, {Int32Field: 4}},
+			},
+		},
+		{
+			mask:  "repeated_message_field[1:].int32_field",
+			paths: []string{"repeated_message_field[1:].int32_field"},
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{{Int32Field: 2}, {Int32Field: 3}, {Int32Field: 4}},
+			},
+		},
+		{
+			mask:  "repeated_message_field.0,repeated_message_field.2",
+			paths: []string{"repeated_message_field.0", "repeated_message_field.2"},
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{list[0], {}},
+			},
+		},
+		{
+			name:  "nested",
+			mask:  "message_field",
+			paths: []string{"message_field"},
+			out: &testpb.Message{
+				MessageField: &testpb.Message{
+					RepeatedMessageField: []*testpb.Message{list[0], list[1], list[3]},
+				},
+			},
+		},
+	} {
+		tt.opts = []Option{distinct}
+		tt.msg = msg
+		tt.run(t)
+	}
+
+	for _, opt := range []Option{
+		WithRepeatedDistinctBy("repeated_int32_field", "string_field"),
+		WithRepeatedDistinctBy("repeated_message_field", "unknown_field"),
+		WithRepeatedDistinctBy("repeated_message_field", "message_field"),
+		WithRepeatedDistinctBy("repeated_message_field", "repeated_string_field"),
+	} {
+		if _, err := Parse[*testpb.Message]("*", opt); err == nil {
+			t.Errorf("Expected error for invalid distinct-by option")
+		}
+	}
+}
+
+func TestIndexedMessageList(t *testing.T) {
+	elem := func(i int32, s string) *testpb.Message {
+		return &testpb.Message{Int32Field: i, StringField: s}
+	}
+	msg := &testpb.Message{
+		RepeatedMessageField: []*testpb.Message{
+			elem(1, "a"),
+			elem(2, "b"),
+			elem(3, "c"),
+			elem(4, "d"),
+		},
+	}
+
+	basicTest{
+		mask:  "repeated_message_field.2.string_field,repeated_message_field.0",
+		paths: []string{"repeated_message_field.0", "repeated_message_field.2.string_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{
+				elem(1, "a"),
+				{},
+				{StringField: "c"},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "repeated_message_field.*.int32_field,repeated_message_field.2.string_field",
+		paths: []string{"repeated_message_field.*.int32_field", "repeated_message_field.2.string_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{
+				{Int32Field: 1},
+				{Int32Field: 2},
+				elem(3, "c"),
+				{Int32Field: 4},
+			},
+		},
+	}.run(t)
+
+	for name, mode := range map[string]UpdateRepeated{
+		"replace": UpdateReplacesRepeated,
+		"append":  UpdateAppendsRepeated,
+	} {
+		updateTest{
+			name: "update:" + name,
+			mask: "repeated_message_field.2.string_field",
+			opts: []Option{WithUpdateRepeated(mode)},
+			dst:  msg,
+			src: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{
+					elem(10, "w"),
+					elem(20, "x"),
+					elem(30, "y"),
+				},
+			},
+			out: &testpb.Message{
+				RepeatedMessageField: []*testpb.Message{
+					elem(1, "a"),
+					elem(2, "b"),
+					elem(3, "y"),
+					elem(4, "d"),
+				},
+			},
+		}.run(t)
+	}
+
+	updateTest{
+		name: "update:src-short",
+		mask: "repeated_message_field.2.string_field",
+		dst:  msg,
+		src: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{elem(10, "w")},
+		},
+		out: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{
+				elem(1, "a"),
+				elem(2, "b"),
+				{Int32Field: 3},
+				elem(4, "d"),
+			},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "update:dst-short",
+		mask: "repeated_message_field.2.string_field,repeated_message_field.5.string_field",
+		dst: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{elem(1, "a")},
+		},
+		src: msg,
+		out: &testpb.Message{
+			RepeatedMessageField: []*testpb.Message{
+				elem(1, "a"),
+				{},
+				{StringField: "c"},
+			},
+		},
+	}.run(t)
+}
+
+func TestListSlice(t *testing.T) {
+	elems := func(vals ...int32) []*testpb.Message {
+		var out []*testpb.Message
+		for _, v := range vals {
+			out = append(out, &testpb.Message{Int32Field: v, StringField: "s"})
+		}
+		return out
+	}
+	msg := &testpb.Message{
+		RepeatedInt32Field:   []int32{1, 2, 3, 4, 5},
+		RepeatedMessageField: elems(1, 2, 3, 4, 5),
+	}
+
+	for _, tt := range []struct {
+		mask  string
+		paths []string
+		ints  []int32
+		msgs  []*testpb.Message
+	}{
+		{
+			mask:  "repeated_int32_field[-3:]",
+			paths: []string{"repeated_int32_field[-3:]"},
+			ints:  []int32{3, 4, 5},
+		},
+		{
+			mask:  "repeated_int32_field.[:2]",
+			paths: []string{"repeated_int32_field[:2]"},
+			ints:  []int32{1, 2},
+		},
+		{
+			mask:  "repeated_int32_field[1:-1]",
+			paths: []string{"repeated_int32_field[1:-1]"},
+			ints:  []int32{2, 3, 4},
+		},
+		{
+			mask:  "repeated_int32_field[-10:10]",
+			paths: []string{"repeated_int32_field[-10:10]"},
+			ints:  []int32{1, 2, 3, 4, 5},
+		},
+		{
+			mask:  "repeated_int32_field[4:2]",
+			paths: []string{"repeated_int32_field[4:2]"},
+			ints:  []int32{},
+		},
+		{
+			mask:  "repeated_int32_field[-3:],repeated_int32_field",
+			paths: []string{"repeated_int32_field"},
+			ints:  []int32{1, 2, 3, 4, 5},
+		},
+		{
+			mask:  "repeated_message_field[3:]",
+			paths: []string{"repeated_message_field[3:]"},
+			msgs:  elems(4, 5),
+		},
+		{
+			mask:  "repeated_message_field[:2].int32_field",
+			paths: []string{"repeated_message_field[:2].int32_field"},
+			msgs:  []*testpb.Message{{Int32Field: 1}, {Int32Field: 2}},
+		},
+		{
+			mask:  "repeated_message_field[:2].int32_field,repeated_message_field[:2].string_field",
+			paths: []string{"repeated_message_field[:2].int32_field", "repeated_message_field[:2].string_field"},
+			msgs:  elems(1, 2),
+		},
+		{
+			mask:  "repeated_message_field[:2].int32_field,repeated_message_field[:2]",
+			paths: []string{"repeated_message_field[:2]"},
+			msgs:  elems(1, 2),
+		},
+		{
+			mask:  "repeated_message_field[-1:],repeated_message_field.*",
+			paths: []string{"repeated_message_field"},
+			msgs:  elems(1, 2, 3, 4, 5),
+		},
+	} {
+		out := &testpb.Message{RepeatedInt32Field: tt.ints, RepeatedMessageField: tt.msgs}
+		if len(tt.ints) == 0 {
+			out.RepeatedInt32Field = nil
+		}
+		basicTest{
+			mask:  tt.mask,
+			paths: tt.paths,
+			msg:   msg,
+			out:   out,
+		}.run(t)
+	}
+
+	for _, mask := range []string{
+		"repeated_int32_field[1]",
+		"repeated_int32_field[a:b]",
+		"repeated_int32_field[+1:]",
+		"repeated_int32_field[1:].foo",
+		"repeated_int32_field[1:],repeated_int32_field[2:]",
+		"repeated_message_field[1:],repeated_message_field[2:]",
+		"repeated_message_field[1:],repeated_message_field.0",
+		"repeated_message_field.*.int32_field,repeated_message_field[1:]",
+		"repeated_message_field[1:].invalid_field",
+		"int32_field[1:]",
+		"map_string_string_field[1:]",
+	} {
+		basicTest{mask: mask, err: true}.run(t)
+	}
+
+	updateTest{
+		name: "update scalar",
+		mask: "repeated_int32_field[-2:]",
+		dst:  &testpb.Message{RepeatedInt32Field: []int32{1, 2, 3, 4}},
+		src:  &testpb.Message{RepeatedInt32Field: []int32{5, 6, 7}},
+		out:  &testpb.Message{RepeatedInt32Field: []int32{1, 2, 6, 7}},
+	}.run(t)
+	updateTest{
+		name: "update middle",
+		mask: "repeated_int32_field[1:2]",
+		dst:  &testpb.Message{RepeatedInt32Field: []int32{1, 2, 3}},
+		src:  &testpb.Message{RepeatedInt32Field: []int32{4, 5, 6}},
+		out:  &testpb.Message{RepeatedInt32Field: []int32{1, 5, 3}},
+	}.run(t)
+	updateTest{
+		name: "update src-nil",
+		mask: "repeated_int32_field[:2]",
+		dst:  &testpb.Message{RepeatedInt32Field: []int32{1, 2, 3}},
+		src:  &testpb.Message{},
+		out:  &testpb.Message{RepeatedInt32Field: []int32{3}},
+	}.run(t)
+	updateTest{
+		name: "update dst-nil",
+		mask: "repeated_int32_field[:2]",
+		dst:  &testpb.Message{},
+		src:  &testpb.Message{RepeatedInt32Field: []int32{1, 2, 3}},
+		out:  &testpb.Message{RepeatedInt32Field: []int32{1, 2}},
+	}.run(t)
+	updateTest{
+		name: "update message",
+		mask: "repeated_message_field[-1:].int32_field",
+		dst:  &testpb.Message{RepeatedMessageField: elems(1, 2)},
+		src:  &testpb.Message{RepeatedMessageField: elems(3, 4)},
+		out: &testpb.Message{RepeatedMessageField: []*testpb.Message{
+			{Int32Field: 1, StringField: "s"},
+			{Int32Field: 4},
+		}},
+	}.run(t)
+
+	fm, err := Parse[*testpb.Message]("repeated_message_field[1:3].int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	for path, want := range map[string]bool{
+		"repeated_message_field.0":              false,
+		"repeated_message_field.1":              true,
+		"repeated_message_field.2.int32_field":  true,
+		"repeated_message_field.2.string_field": false,
+		"repeated_message_field.3":              false,
+	} {
+		if got := fm.CoversPresent(msg, path); got != want {
+			t.Errorf("CoversPresent(%q): got: %v; want: %v", path, got, want)
+		}
+	}
+	if !fm.WouldModify(clone(msg)) {
+		t.Error("WouldModify: expected modification")
+	}
+	sliced, err := Parse[*testpb.Message]("repeated_int32_field[:]")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if sliced.WouldModify(&testpb.Message{RepeatedInt32Field: []int32{1, 2}}) {
+		t.Error("WouldModify: unexpected modification")
+	}
+}
+
+func TestDisallowScalarListSubpaths(t *testing.T) {
+	disallow := WithDisallowScalarListSubpaths(true)
+	for _, tt := range []pathTest{
+		{
+			input: "repeated_int32_field,repeated_message_field.*.int32_field",
+			opts:  []Option{disallow},
+			paths: []string{"repeated_int32_field", "repeated_message_field.*.int32_field"},
+		},
+		{
+			input: "message_field.repeated_string_field",
+			opts:  []Option{disallow},
+			paths: []string{"message_field.repeated_string_field"},
+		},
+		{
+			input: "repeated_int32_field.*",
+			opts:  []Option{disallow},
+			err:   true,
+		},
+		{
+			input: "repeated_int32_field[-3:]",
+			opts:  []Option{disallow},
+			err:   true,
+		},
+		{
+			input: "repeated_int32_field,message_field.repeated_string_field.*",
+			opts:  []Option{disallow},
+			err:   true,
+		},
+		{
+			name:  "default",
+			input: "repeated_int32_field.*",
+			paths: []string{"repeated_int32_field"},
+		},
+	} {
+		tt.run(t)
+	}
+}
+
+func TestUpdateClearsEmptyLists(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		opts  []Option
+		empty bool
+	}{
+		{
+			name:  "default",
+			empty: true,
+		},
+		{
+			name: "clear",
+			opts: []Option{WithUpdateClearsEmptyLists(true)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("repeated_int32_field[:1],repeated_message_field[:1]", tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := &testpb.Message{
+				RepeatedInt32Field:   []int32{1},
+				RepeatedMessageField: []*testpb.Message{simpleMsg(1, "foo")},
+			}
+			if err := fm.Update(dst, &testpb.Message{}); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			// An emptied list is left as an empty slice unless it's cleared.
+			if got := dst.RepeatedInt32Field != nil; got != tt.empty {
+				t.Errorf("Update: unexpected scalar list presence: got: %v; want: %v", got, tt.empty)
+			}
+			if got := dst.RepeatedMessageField != nil; got != tt.empty {
+				t.Errorf("Update: unexpected message list presence: got: %v; want: %v", got, tt.empty)
+			}
+		})
+	}
+}
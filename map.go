@@ -8,10 +8,12 @@ package fieldmask
 
 import (
 	"fmt"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
 
+	"bursavich.dev/fieldmask/internal/quote"
 	"golang.org/x/exp/constraints"
 	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -33,7 +35,7 @@ type keyFuncs[T constraints.Ordered] struct {
 
 func (fn *keyFuncs[T]) key(s string) (key T, err error) {
 	if strings.HasPrefix(s, "`") {
-		s, err = strconv.Unquote(s)
+		s, err = quote.Unquote(s, '`')
 		if err != nil {
 			return key, err
 		}
@@ -41,12 +43,46 @@ func (fn *keyFuncs[T]) key(s string) (key T, err error) {
 	return fn.parse(s)
 }
 
+// formatKeys returns the sorted and formatted keys.
+func (fn *keyFuncs[T]) formatKeys(keys []T) []string {
+	slices.Sort(keys)
+	out := make([]string, len(keys))
+	for i, key := range keys {
+		out[i] = fn.format(key)
+	}
+	return out
+}
+
 var stringKeyFuncs = keyFuncs[string]{
 	value:  protoreflect.MapKey.String,
 	format: func(v string) string { return v },
 	parse:  func(v string) (string, error) { return v, nil },
 }
 
+var percentStringKeyFuncs = keyFuncs[string]{
+	value:  protoreflect.MapKey.String,
+	format: percentEncode,
+	parse:  url.PathUnescape,
+}
+
+// percentEncode percent-encodes every byte of s that isn't an unreserved character.
+// Although it's unreserved, '.' is encoded because it may be used as a path separator.
+func percentEncode(s string) string {
+	const upperhex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(upperhex[c>>4])
+			b.WriteByte(upperhex[c&0xF])
+		}
+	}
+	return b.String()
+}
+
 var int32KeyFuncs = keyFuncs[int32]{
 	value:  func(v protoreflect.MapKey) int32 { return int32(v.Int()) },
 	format: func(v int32) string { return strconv.FormatInt(int64(v), 10) },
@@ -93,6 +129,12 @@ var boolKeyFuncs = keyFuncs[byte]{
 	},
 }
 
+var numericBoolKeyFuncs = keyFuncs[byte]{
+	value:  boolKeyFuncs.value,
+	format: func(b byte) string { return strconv.Itoa(int(b)) },
+	parse:  boolKeyFuncs.parse,
+}
+
 func boolToByte(b bool) byte {
 	if b {
 		return 1
@@ -100,6 +142,18 @@ func boolToByte(b bool) byte {
 	return 0
 }
 
+// A mapKeysMask is a map field mask that reports its selected keys.
+type mapKeysMask interface {
+	// selectedKeys returns the sorted and formatted keys that are explicitly selected
+	// and a value indicating if every key is selected.
+	selectedKeys() (keys []string, all bool)
+}
+
+var (
+	_ mapKeysMask = (*scalarMapFieldMask[string])(nil)
+	_ mapKeysMask = (*msgMapFieldMask[string])(nil)
+)
+
 type scalarMapFieldMask[T constraints.Ordered] struct {
 	desc protoreflect.FieldDescriptor
 	keys map[T]bool
@@ -113,14 +167,14 @@ func newScalarMapFieldMask(settings *settings, desc protoreflect.FieldDescriptor
 	case protoreflect.StringKind:
 		return &scalarMapFieldMask[string]{
 			desc:     desc,
-			keyFuncs: stringKeyFuncs,
+			keyFuncs: settings.stringKeyFuncs(desc),
 			settings: settings,
 		}
 	case protoreflect.BoolKind:
 		// NOTE: We're using a byte because bool is not Ordered and we sort the keys when generating paths.
 		return &scalarMapFieldMask[byte]{
 			desc:     desc,
-			keyFuncs: boolKeyFuncs,
+			keyFuncs: settings.boolKeyFuncs(),
 			settings: settings,
 		}
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
@@ -169,7 +223,7 @@ func (fm *scalarMapFieldMask[T]) add(path string) error {
 	if path == "" || path == "*" {
 		return fm.addWild("")
 	}
-	name, subpath, err := nextSegment(path)
+	name, subpath, err := nextSegment(path, fm.settings.pathSep)
 	if err != nil {
 		return err
 	}
@@ -195,6 +249,11 @@ func (fm *scalarMapFieldMask[T]) addKeyed(key, subpath string) error {
 	if subpath != "" {
 		return fmt.Errorf("invalid scalar field subpath: %q", subpath)
 	}
+	if !fm.keys[k] {
+		if err := fm.settings.checkMapKeys(fm.desc, len(fm.keys)); err != nil {
+			return err
+		}
+	}
 	if fm.keys == nil {
 		fm.keys = make(map[T]bool)
 	}
@@ -210,19 +269,38 @@ func (fm *scalarMapFieldMask[T]) paths() []string {
 	slices.Sort(keys)
 	paths := make([]string, len(keys))
 	for i, key := range keys {
-		paths[i] = maybeQuote(fm.format(key))
+		paths[i] = fm.settings.quoteKey(fm.format(key))
 	}
 	return paths
 }
 
-func (fm *scalarMapFieldMask[T]) mask(parent protoreflect.Message, value protoreflect.Value) {
+func (fm *scalarMapFieldMask[T]) coversPath(path string) bool {
+	if fm.complete() {
+		return true
+	}
+	name, subpath, err := nextSegment(path, fm.settings.pathSep)
+	if err != nil || name == "*" || subpath != "" {
+		return false
+	}
+	k, err := fm.key(name)
+	return err == nil && fm.keys[k]
+}
+
+func (fm *scalarMapFieldMask[T]) selectedKeys() ([]string, bool) {
+	return fm.formatKeys(maps.Keys(fm.keys)), fm.complete()
+}
+
+func (fm *scalarMapFieldMask[T]) mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState) {
 	if fm.complete() {
+		fm.settings.filterMapKeys(value.Map(), fm.desc, path)
+		fm.settings.filterScalarMap(value.Map(), fm.desc, path)
 		return
 	}
 	protoMap := value.Map()
 	protoMap.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-		if !fm.keys[fm.value(key)] {
+		if !fm.keys[fm.value(key)] || !fm.settings.allowKey(fm.desc, key) || !fm.settings.allowScalar(fm.desc, val) {
 			protoMap.Clear(key)
+			fm.settings.clearedKey(path, fm.desc, key)
 			return true
 		}
 		protoMap.Set(key, val)
@@ -230,23 +308,16 @@ func (fm *scalarMapFieldMask[T]) mask(parent protoreflect.Message, value protore
 	})
 }
 
-func (fm *scalarMapFieldMask[T]) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
+func (fm *scalarMapFieldMask[T]) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
 	src := value.Map()
 	dst := parent.NewField(fm.desc).Map()
 	switch {
 	case fm.complete():
-		fm.settings.copyMap(dst, src, fm.desc)
-	case fm.desc.MapValue().Kind() == protoreflect.BytesKind:
-		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			if fm.keys[fm.value(key)] {
-				dst.Set(key, cloneBytesValue(val))
-			}
-			return true
-		})
+		fm.settings.copyMap(dst, src, fm.desc, c)
 	default:
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			if fm.keys[fm.value(key)] {
-				dst.Set(key, val)
+			if fm.keys[fm.value(key)] && fm.settings.allowKey(fm.desc, key) && fm.settings.allowScalar(fm.desc, val) {
+				dst.Set(key, fm.settings.cloneScalar(fm.desc, val, c))
 			}
 			return true
 		})
@@ -254,12 +325,15 @@ func (fm *scalarMapFieldMask[T]) clone(parent protoreflect.Message, value protor
 	return protoreflect.ValueOfMap(dst)
 }
 
-func (fm *scalarMapFieldMask[T]) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
+func (fm *scalarMapFieldMask[T]) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
 	switch {
 	case !value.IsValid() || !value.Map().IsValid():
 		fm.clear(parent)
-	case fm.complete():
+	case fm.complete() && fm.settings.leafMerger == nil && fm.settings.mapDeletePrefix == "" &&
+		fm.desc.MapValue().Kind() != protoreflect.BytesKind: // Bytes are copied by updateMap.
 		parent.Set(fm.desc, value)
+	case fm.complete():
+		fm.settings.updateMap(parent.Mutable(fm.desc).Map(), value.Map(), fm.desc, c)
 	default:
 		src := value.Map()
 		dst := parent.Mutable(fm.desc).Map()
@@ -272,12 +346,16 @@ func (fm *scalarMapFieldMask[T]) update(parent protoreflect.Message, value proto
 		})
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
 			// Set values that have a mask.
-			if fm.keys[fm.value(key)] {
-				dst.Set(key, val)
+			if fm.keys[fm.value(key)] && !fm.settings.isTombstone(fm.desc, key) {
+				dst.Set(key, fm.settings.mergeMapValue(fm.desc, dst.Get(key), val))
 			}
 			return true
 		})
+		fm.settings.applyTombstones(dst, src, fm.desc, func(key protoreflect.MapKey) bool {
+			return fm.keys[fm.value(key)]
+		})
 	}
+	fm.settings.clearEmptyMap(parent, fm.desc, c)
 }
 
 func (fm *scalarMapFieldMask[T]) clear(parent protoreflect.Message) {
@@ -297,6 +375,26 @@ func (fm *scalarMapFieldMask[T]) clear(parent protoreflect.Message) {
 	}
 }
 
+func (fm *scalarMapFieldMask[T]) covers(value protoreflect.Value, path string) bool {
+	if path == "" {
+		return true
+	}
+	key, subpath, ok := fm.settings.presentMapKey(fm.desc, value.Map(), path)
+	return ok && subpath == "" && (fm.complete() || fm.keys[fm.value(key)])
+}
+
+func (fm *scalarMapFieldMask[T]) modifies(value protoreflect.Value) bool {
+	if fm.complete() {
+		return fm.settings.filtersMap(value.Map(), fm.desc)
+	}
+	modified := false
+	value.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		modified = !fm.keys[fm.value(key)] || !fm.settings.allowKey(fm.desc, key) || !fm.settings.allowScalar(fm.desc, val)
+		return !modified
+	})
+	return modified
+}
+
 type msgMapFieldMask[T constraints.Ordered] struct {
 	desc       protoreflect.FieldDescriptor
 	wildMask   *msgMask
@@ -311,14 +409,14 @@ func newMsgMapFieldMask(settings *settings, desc protoreflect.FieldDescriptor) f
 	case protoreflect.StringKind:
 		return &msgMapFieldMask[string]{
 			desc:     desc,
-			keyFuncs: stringKeyFuncs,
+			keyFuncs: settings.stringKeyFuncs(desc),
 			settings: settings,
 		}
 	case protoreflect.BoolKind:
 		// NOTE: We're using a byte because bool is not Ordered and we sort the keys when generating paths.
 		return &msgMapFieldMask[byte]{
 			desc:     desc,
-			keyFuncs: boolKeyFuncs,
+			keyFuncs: settings.boolKeyFuncs(),
 			settings: settings,
 		}
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
@@ -367,7 +465,15 @@ func (fm *msgMapFieldMask[T]) add(path string) error {
 	if path == "" || path == "*" {
 		return fm.addWild("")
 	}
-	name, subpath, err := nextSegment(path)
+	if prefix, ok := strings.CutSuffix(path, string(fm.settings.pathSep)); ok {
+		// A trailing separator denotes an explicitly empty subpath, which empties the values.
+		name, subpath, err := nextSegment(prefix, fm.settings.pathSep)
+		if err != nil || subpath != "" {
+			return errSyntax
+		}
+		return fm.addEmptied(name)
+	}
+	name, subpath, err := nextSegment(path, fm.settings.pathSep)
 	if err != nil {
 		return err
 	}
@@ -378,6 +484,8 @@ func (fm *msgMapFieldMask[T]) add(path string) error {
 }
 
 func (fm *msgMapFieldMask[T]) addWild(subpath string) error {
+	fm.settings.state.mapValueDepth++
+	defer func() { fm.settings.state.mapValueDepth-- }()
 	if subpath == "" {
 		fm.wildMask = nil
 		fm.keyedMasks = nil
@@ -392,6 +500,9 @@ func (fm *msgMapFieldMask[T]) addWild(subpath string) error {
 	} else if err := fm.wildMask.append(subpath); err != nil {
 		return err
 	}
+	if fm.settings.keyedOverridesWild {
+		return nil
+	}
 	for _, m := range fm.keyedMasks {
 		if err := m.append(subpath); err != nil {
 			panic(fmt.Sprintf("fieldmask: internal error: successful wild mask append failed on keyed mask: %q: %v", subpath, err))
@@ -400,7 +511,37 @@ func (fm *msgMapFieldMask[T]) addWild(subpath string) error {
 	return nil
 }
 
+// addEmptied adds an empty mask for the values with the given key, or all values if the key is "*".
+// The entries are kept but their values are emptied, unless other paths select fields of the values.
+func (fm *msgMapFieldMask[T]) addEmptied(key string) error {
+	if key == "*" {
+		if fm.wildMask == nil {
+			fm.wildMask = newEmptyMsgMask(fm.settings, fm.desc.MapValue().Message())
+		}
+		return nil
+	}
+	k, err := fm.key(key)
+	if err != nil {
+		return err
+	}
+	if _, ok := fm.keyedMasks[k]; ok {
+		return nil
+	}
+	if err := fm.settings.checkMapKeys(fm.desc, len(fm.keyedMasks)); err != nil {
+		return err
+	}
+	m := newEmptyMsgMask(fm.settings, fm.desc.MapValue().Message())
+	fm.inheritWild(m)
+	if fm.keyedMasks == nil {
+		fm.keyedMasks = make(map[T]*msgMask)
+	}
+	fm.keyedMasks[k] = m
+	return nil
+}
+
 func (fm *msgMapFieldMask[T]) addKeyed(key, subpath string) error {
+	fm.settings.state.mapValueDepth++
+	defer func() { fm.settings.state.mapValueDepth-- }()
 	k, err := fm.key(key)
 	if err != nil {
 		return err
@@ -408,18 +549,15 @@ func (fm *msgMapFieldMask[T]) addKeyed(key, subpath string) error {
 	if m, ok := fm.keyedMasks[k]; ok {
 		return m.append(subpath)
 	}
+	if err := fm.settings.checkMapKeys(fm.desc, len(fm.keyedMasks)); err != nil {
+		return err
+	}
 
 	m := newMsgMask(fm.settings, fm.desc.MapValue().Message())
 	if err := m.init(subpath); err != nil {
 		return err
 	}
-	if fm.wildMask != nil {
-		for _, path := range fm.wildMask.paths() {
-			if err := m.append(path); err != nil {
-				panic(fmt.Sprintf("fieldmask: internal error: successful wild mask append failed on keyed mask: %q: %v", subpath, err))
-			}
-		}
-	}
+	fm.inheritWild(m)
 	if fm.keyedMasks == nil {
 		fm.keyedMasks = make(map[T]*msgMask)
 	}
@@ -427,13 +565,29 @@ func (fm *msgMapFieldMask[T]) addKeyed(key, subpath string) error {
 	return nil
 }
 
+// inheritWild appends the paths of the wild mask, if any, to the new keyed mask,
+// unless keyed masks override the wild mask.
+func (fm *msgMapFieldMask[T]) inheritWild(m *msgMask) {
+	if fm.wildMask == nil || fm.settings.keyedOverridesWild {
+		return
+	}
+	for _, path := range fm.wildMask.paths() {
+		if err := m.append(path); err != nil {
+			panic(fmt.Sprintf("fieldmask: internal error: successful wild mask append failed on keyed mask: %q: %v", path, err))
+		}
+	}
+}
+
 func (fm *msgMapFieldMask[T]) paths() []string {
 	var wild []string
 	var paths []string
 	if fm.wildMask != nil {
 		wild = fm.wildMask.paths()
 		for _, sub := range wild {
-			paths = append(paths, joinPath("*", sub))
+			paths = append(paths, joinPath("*", sub, fm.settings.pathSep))
+		}
+		if len(wild) == 0 {
+			paths = append(paths, joinPath("*", "", fm.settings.pathSep))
 		}
 	}
 	if fm.keyedMasks == nil {
@@ -444,23 +598,67 @@ func (fm *msgMapFieldMask[T]) paths() []string {
 	keys := maps.Keys(fm.keyedMasks)
 	slices.Sort(keys)
 	for _, key := range keys {
-		name := maybeQuote(fm.format(key))
-		subs := fm.keyedMasks[key].paths()
+		name := fm.settings.quoteKey(fm.format(key))
+		m := fm.keyedMasks[key]
+		subs := m.paths()
 		if len(subs) == 0 {
+			if !m.complete() {
+				name = joinPath(name, "", fm.settings.pathSep)
+			}
 			paths = append(paths, name)
 			continue
 		}
-		if !lazyNeedles {
+		if !lazyNeedles && !fm.settings.keyedOverridesWild {
 			needles = toSet(wild)
 			lazyNeedles = true
 		}
 		for _, sub := range remove(subs, needles) {
-			paths = append(paths, joinPath(name, sub))
+			paths = append(paths, joinPath(name, sub, fm.settings.pathSep))
 		}
 	}
 	return paths
 }
 
+func (fm *msgMapFieldMask[T]) coversPath(path string) bool {
+	if fm.complete() {
+		return true
+	}
+	if path == "" || path == "*" {
+		return false
+	}
+	// A trailing separator selects the entries with emptied values,
+	// which any mask of the values covers.
+	prefix, emptied := strings.CutSuffix(path, string(fm.settings.pathSep))
+	name, subpath, err := nextSegment(prefix, fm.settings.pathSep)
+	if err != nil || emptied && subpath != "" {
+		return false
+	}
+	if name == "*" {
+		if fm.wildMask == nil || emptied {
+			return fm.wildMask != nil
+		}
+		if !fm.wildMask.coversPath(subpath) {
+			return false
+		}
+		// Keyed masks may override the wild mask.
+		for _, m := range fm.keyedMasks {
+			if !m.coversPath(subpath) {
+				return false
+			}
+		}
+		return true
+	}
+	k, err := fm.key(name)
+	if err != nil {
+		return false
+	}
+	m, ok := fm.keyedMasks[k]
+	if !ok {
+		m = fm.wildMask
+	}
+	return m != nil && (emptied || m.coversPath(subpath))
+}
+
 func (fm *msgMapFieldMask[T]) lookupMask(key protoreflect.MapKey) (*msgMask, bool) {
 	if fm.keyedMasks != nil {
 		if m, ok := fm.keyedMasks[fm.value(key)]; ok {
@@ -473,32 +671,47 @@ func (fm *msgMapFieldMask[T]) lookupMask(key protoreflect.MapKey) (*msgMask, boo
 	return nil, false
 }
 
-func (fm *msgMapFieldMask[T]) mask(parent protoreflect.Message, value protoreflect.Value) {
+func (fm *msgMapFieldMask[T]) selectedKeys() ([]string, bool) {
+	return fm.formatKeys(maps.Keys(fm.keyedMasks)), fm.complete() || fm.wildMask != nil
+}
+
+func (fm *msgMapFieldMask[T]) mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState) {
 	if fm.complete() {
+		fm.settings.filterMap(value.Map(), fm.desc, path, c)
+		fm.settings.dropEmptyValues(value.Map(), fm.desc, func(key protoreflect.MapKey) {
+			fm.settings.clearedKey(path, fm.desc, key)
+		})
 		return
 	}
 	protoMap := value.Map()
 	protoMap.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
 		m, ok := fm.lookupMask(key)
-		if !ok {
+		if !ok || !fm.settings.allowKey(fm.desc, key) {
 			protoMap.Clear(key)
+			fm.settings.clearedKey(path, fm.desc, key)
 			return true
 		}
-		m.mask(val.Message())
+		m.mask(val.Message(), fm.settings.mapKeyPath(path, fm.desc, key), c)
 		return true
 	})
+	fm.settings.dropEmptyValues(protoMap, fm.desc, func(key protoreflect.MapKey) {
+		fm.settings.clearedKey(path, fm.desc, key)
+	})
 }
 
-func (fm *msgMapFieldMask[T]) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
+func (fm *msgMapFieldMask[T]) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
 	src := value.Map()
 	dst := parent.NewField(fm.desc).Map()
 	switch {
 	case fm.complete():
-		fm.settings.copyMap(dst, src, fm.desc)
+		fm.settings.copyMap(dst, src, fm.desc, c)
+		fm.settings.dropEmptyValues(dst, fm.desc, nil)
 	default:
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			if m, ok := fm.lookupMask(key); ok {
-				dst.Set(key, protoreflect.ValueOfMessage(m.clone(val.Message())))
+			if m, ok := fm.lookupMask(key); ok && fm.settings.allowKey(fm.desc, key) {
+				if v := protoreflect.ValueOfMessage(m.clone(val.Message(), c)); !fm.settings.emptyMapValue(fm.desc, v) {
+					dst.Set(key, v)
+				}
 			}
 			return true
 		})
@@ -506,12 +719,12 @@ func (fm *msgMapFieldMask[T]) clone(parent protoreflect.Message, value protorefl
 	return protoreflect.ValueOfMap(dst)
 }
 
-func (fm *msgMapFieldMask[T]) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
+func (fm *msgMapFieldMask[T]) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
 	switch {
 	case !value.IsValid() || !value.Map().IsValid():
 		fm.clear(parent)
 	case fm.complete():
-		fm.settings.updateMap(parent.Mutable(fm.desc).Map(), value.Map(), fm.desc)
+		fm.settings.updateMap(parent.Mutable(fm.desc).Map(), value.Map(), fm.desc, c)
 	default:
 		src := value.Map()
 		dst := parent.Mutable(fm.desc).Map()
@@ -524,12 +737,23 @@ func (fm *msgMapFieldMask[T]) update(parent protoreflect.Message, value protoref
 		})
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
 			// Update values that have a mask.
-			if m, ok := fm.lookupMask(key); ok {
-				m.update(dst.Mutable(key).Message(), val.Message())
+			m, ok := fm.lookupMask(key)
+			switch {
+			case !ok || fm.settings.isTombstone(fm.desc, key):
+				// no-op
+			case fm.settings.replaceMapValue(fm.desc, key, fm.keyedMasks[fm.value(key)] != nil):
+				dst.Set(key, protoreflect.ValueOfMessage(m.clone(val.Message(), c)))
+			default:
+				m.update(dst.Mutable(key).Message(), val.Message(), c)
 			}
 			return true
 		})
+		fm.settings.applyTombstones(dst, src, fm.desc, func(key protoreflect.MapKey) bool {
+			_, ok := fm.lookupMask(key)
+			return ok
+		})
 	}
+	fm.settings.clearEmptyMap(parent, fm.desc, c)
 }
 
 func (fm *msgMapFieldMask[T]) clear(parent protoreflect.Message) {
@@ -549,6 +773,39 @@ func (fm *msgMapFieldMask[T]) clear(parent protoreflect.Message) {
 	}
 }
 
+func (fm *msgMapFieldMask[T]) covers(value protoreflect.Value, path string) bool {
+	if path == "" {
+		return true
+	}
+	key, subpath, ok := fm.settings.presentMapKey(fm.desc, value.Map(), path)
+	if !ok {
+		return false
+	}
+	elem := value.Map().Get(key).Message()
+	if fm.complete() {
+		return newMsgMask(fm.settings, fm.desc.MapValue().Message()).covers(elem, subpath)
+	}
+	m, ok := fm.lookupMask(key)
+	return ok && m.covers(elem, subpath)
+}
+
+func (fm *msgMapFieldMask[T]) modifies(value protoreflect.Value) bool {
+	modified := false
+	value.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		switch {
+		case fm.complete():
+			modified = fm.settings.filtersMapEntry(fm.desc, key, val)
+		default:
+			m, ok := fm.lookupMask(key)
+			modified = !ok || !fm.settings.allowKey(fm.desc, key) || m.modifies(val.Message())
+		}
+		// A value that isn't otherwise modified is only dropped if it's already empty.
+		modified = modified || fm.settings.emptyMapValue(fm.desc, val)
+		return !modified
+	})
+	return modified
+}
+
 func remove(haystack []string, needles map[string]bool) []string {
 	if len(needles) == 0 {
 		return haystack
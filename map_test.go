@@ -111,7 +111,7 @@ func newMapTest[K comparable](keyType, valueType string, sort func([]K), mutate
 	sort(keys)
 	paths := make([]string, len(keys))
 	for i, k := range keys {
-		paths[i] = field + "." + maybeQuote(fmt.Sprint(k))
+		paths[i] = field + "." + maybeQuote(fmt.Sprint(k), defaultPathSep)
 	}
 	return &mapTest[K]{
 		field:   field,
@@ -552,3 +552,941 @@ func sortBool(s []bool) {
 		return 1
 	})
 }
+
+func TestBytesMapAliasing(t *testing.T) {
+	newMsg := func() *testpb.Message {
+		return &testpb.Message{
+			MapStringBytesField: map[string][]byte{"a": []byte("aa"), "b": []byte("bb")},
+		}
+	}
+	mutate := func(m *testpb.Message) {
+		for _, b := range m.MapStringBytesField {
+			b[0] = 'x'
+		}
+	}
+	for _, tt := range []struct {
+		mask string
+		opts []Option
+		out  *testpb.Message
+	}{
+		{
+			mask: "map_string_bytes_field",
+			out:  newMsg(),
+		},
+		{
+			mask: "map_string_bytes_field.a",
+			out:  &testpb.Message{MapStringBytesField: map[string][]byte{"a": []byte("aa")}},
+		},
+		{
+			mask: "map_string_bytes_field",
+			opts: []Option{WithUpdateMapDeletePrefix("-")},
+			out:  newMsg(),
+		},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+
+		src := newMsg()
+		out := fm.Clone(src)
+		mutate(src)
+		if diff := protoDiff(tt.out, out); diff != "" {
+			t.Errorf("Clone(%q): shares bytes with source:\n%s", tt.mask, diff)
+		}
+
+		src = newMsg()
+		dst := &testpb.Message{}
+		if err := fm.Update(dst, src); err != nil {
+			t.Fatalf("Update(%q): unexpected error: %v", tt.mask, err)
+		}
+		mutate(src)
+		if diff := protoDiff(tt.out, dst); diff != "" {
+			t.Errorf("Update(%q): shares bytes with source:\n%s", tt.mask, diff)
+		}
+
+		// Masking in place keeps the message's own bytes.
+		msg := newMsg()
+		a := msg.MapStringBytesField["a"]
+		fm.Mask(msg)
+		if &msg.MapStringBytesField["a"][0] != &a[0] {
+			t.Errorf("Mask(%q): copied bytes", tt.mask)
+		}
+		if diff := protoDiff(tt.out, msg); diff != "" {
+			t.Errorf("Mask(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+	}
+}
+
+func TestUpdateClearsEmptyMaps(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		opts  []Option
+		empty bool
+	}{
+		{
+			name:  "default",
+			empty: true,
+		},
+		{
+			name: "clear",
+			opts: []Option{WithUpdateClearsEmptyMaps(true)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("map_string_string_field.foo,map_string_message_field.foo", tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := &testpb.Message{
+				MapStringStringField:  map[string]string{"foo": "foo"},
+				MapStringMessageField: map[string]*testpb.Message{"foo": simpleMsg(1, "foo")},
+			}
+			if err := fm.Update(dst, &testpb.Message{}); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if got := dst.MapStringStringField != nil; got != tt.empty {
+				t.Errorf("Update: unexpected string map presence: got: %v; want: %v", got, tt.empty)
+			}
+			if got := dst.MapStringMessageField != nil; got != tt.empty {
+				t.Errorf("Update: unexpected message map presence: got: %v; want: %v", got, tt.empty)
+			}
+		})
+	}
+}
+
+func TestMaxMapKeys(t *testing.T) {
+	for _, field := range []string{"map_int32_string_field", "map_int32_message_field"} {
+		basicTest{
+			name: field + ":within-limit",
+			mask: joinMasks(field+".1", field+".2", field+".1"),
+			opts: []Option{WithMaxMapKeys(2)},
+			paths: []string{
+				field + ".1",
+				field + ".2",
+			},
+			msg: &testpb.Message{},
+			out: &testpb.Message{},
+		}.run(t)
+
+		basicTest{
+			name: field + ":exceeds-limit",
+			mask: joinMasks(field+".1", field+".2", field+".3"),
+			opts: []Option{WithMaxMapKeys(2)},
+			err:  true,
+		}.run(t)
+	}
+}
+
+func TestMapKeyFilter(t *testing.T) {
+	positive := func(key protoreflect.MapKey) bool { return key.Int() > 0 }
+	opts := []Option{
+		WithMapKeyFilter("map_int32_string_field", positive),
+		WithMapKeyFilter("message_field.map_int32_message_field", positive),
+	}
+	msg := &testpb.Message{
+		MapInt32StringField: clone(testMsg).MapInt32StringField,
+		MessageField: &testpb.Message{
+			MapInt32MessageField: clone(testMsg).MapInt32MessageField,
+		},
+	}
+
+	basicTest{
+		name:  "complete",
+		mask:  "map_int32_string_field,message_field",
+		opts:  opts,
+		paths: []string{"map_int32_string_field", "message_field"},
+		msg:   msg,
+		out: func() *testpb.Message {
+			out := clone(msg)
+			delete(out.MapInt32StringField, -1)
+			delete(out.MessageField.MapInt32MessageField, -1)
+			return out
+		}(),
+	}.run(t)
+
+	basicTest{
+		name: "keyed",
+		mask: joinMasks(
+			"map_int32_string_field.-1",
+			"map_int32_string_field.2",
+			"message_field.map_int32_message_field.-1",
+			"message_field.map_int32_message_field.3.int32_field",
+		),
+		opts: opts,
+		paths: []string{
+			"map_int32_string_field.-1",
+			"map_int32_string_field.2",
+			"message_field.map_int32_message_field.-1",
+			"message_field.map_int32_message_field.3.int32_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			MapInt32StringField: map[int32]string{2: msg.MapInt32StringField[2]},
+			MessageField: &testpb.Message{
+				MapInt32MessageField: map[int32]*testpb.Message{3: {Int32Field: 3}},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		name:  "wild",
+		mask:  "message_field.map_int32_message_field.*.int32_field",
+		opts:  opts,
+		paths: []string{"message_field.map_int32_message_field.*.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MessageField: &testpb.Message{
+				MapInt32MessageField: map[int32]*testpb.Message{
+					1: {Int32Field: 1},
+					2: {Int32Field: 2},
+					3: {Int32Field: 3},
+				},
+			},
+		},
+	}.run(t)
+
+	for _, path := range []string{"int32_field", "invalid_field", "repeated_message_field.map_int32_string_field"} {
+		basicTest{
+			name: "invalid-filter:" + path,
+			mask: "*",
+			opts: []Option{WithMapKeyFilter(path, positive)},
+			err:  true,
+		}.run(t)
+	}
+}
+
+func TestPercentEncodedKeys(t *testing.T) {
+	keys := []string{"a/b", "a.b", "a b", "*", "%", "`", "", "a,b"}
+	msg := &testpb.Message{MapStringStringField: map[string]string{"other": "other"}}
+	for _, key := range keys {
+		msg.MapStringStringField[key] = key
+	}
+	for _, key := range keys {
+		fm, err := PopulatedMask(&testpb.Message{
+			MapStringStringField: map[string]string{key: key},
+		}, WithKeyEncoding(PercentEncoding))
+		if err != nil {
+			t.Fatalf("PopulatedMask: unexpected error for key %q: %v", key, err)
+		}
+		fm, err = Parse[*testpb.Message](fm.String(), WithKeyEncoding(PercentEncoding))
+		if err != nil {
+			t.Fatalf("Parse: unexpected error for key %q: %v", key, err)
+		}
+		want := &testpb.Message{MapStringStringField: map[string]string{key: key}}
+		if diff := protoDiff(want, fm.Clone(msg)); diff != "" {
+			t.Fatalf("Clone: unexpected diff for key %q:\n%s", key, diff)
+		}
+	}
+}
+
+func TestMapValueUpdate(t *testing.T) {
+	dst := &testpb.Message{
+		MapInt32MessageField: map[int32]*testpb.Message{
+			1: {Int32Field: 1, StringField: "dst"},
+			2: {Int32Field: 2, StringField: "dst"},
+			3: {Int32Field: 3, StringField: "dst"},
+		},
+	}
+	src := &testpb.Message{
+		MapInt32MessageField: map[int32]*testpb.Message{
+			1: {Int32Field: 10, StringField: "src"},
+			2: {Int32Field: 20, StringField: "src"},
+			3: {Int32Field: 30, StringField: "src"},
+		},
+	}
+
+	updateTest{
+		name: "keyed",
+		mask: "map_int32_message_field.1.int32_field,map_int32_message_field.2.int32_field",
+		opts: []Option{WithMapValueUpdate("map_int32_message_field", ReplaceMapValues, "1")},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			MapInt32MessageField: map[int32]*testpb.Message{
+				1: {Int32Field: 10},
+				2: {Int32Field: 20, StringField: "dst"},
+				3: {Int32Field: 3, StringField: "dst"},
+			},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "wild",
+		mask: "map_int32_message_field.*.int32_field,map_int32_message_field.2.int32_field",
+		opts: []Option{WithMapValueUpdate("map_int32_message_field", ReplaceMapValues, "*")},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			MapInt32MessageField: map[int32]*testpb.Message{
+				1: {Int32Field: 10},
+				2: {Int32Field: 20, StringField: "dst"},
+				3: {Int32Field: 30},
+			},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "wild-merge-key",
+		mask: "map_int32_message_field.*.int32_field",
+		opts: []Option{
+			WithMapValueUpdate("map_int32_message_field", ReplaceMapValues, "*"),
+			WithMapValueUpdate("map_int32_message_field", MergeMapValues, "3"),
+		},
+		dst: dst,
+		src: src,
+		out: &testpb.Message{
+			MapInt32MessageField: map[int32]*testpb.Message{
+				1: {Int32Field: 10},
+				2: {Int32Field: 20},
+				3: {Int32Field: 30, StringField: "dst"},
+			},
+		},
+	}.run(t)
+
+	for _, tt := range []struct {
+		path string
+		key  string
+	}{
+		{"int32_field", "1"},
+		{"map_int32_string_field", "1"},
+		{"map_int32_message_field", "foo"},
+	} {
+		basicTest{
+			name: "invalid:" + tt.path + ":" + tt.key,
+			mask: "*",
+			opts: []Option{WithMapValueUpdate(tt.path, ReplaceMapValues, tt.key)},
+			err:  true,
+		}.run(t)
+	}
+}
+
+func TestEmptiedMapValues(t *testing.T) {
+	msg := &testpb.Message{
+		MapStringMessageField: map[string]*testpb.Message{
+			"foo": {Int32Field: 1, StringField: "foo"},
+			"bar": {Int32Field: 2, StringField: "bar"},
+		},
+	}
+
+	basicTest{
+		mask:  "map_string_message_field.*.",
+		paths: []string{"map_string_message_field.*."},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {},
+				"bar": {},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "map_string_message_field.foo.",
+		paths: []string{"map_string_message_field.foo."},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "map_string_message_field.*.,map_string_message_field.foo.int32_field",
+		paths: []string{"map_string_message_field.*.", "map_string_message_field.foo.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {Int32Field: 1},
+				"bar": {},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "map_string_message_field.foo.int32_field,map_string_message_field.foo.",
+		paths: []string{"map_string_message_field.foo.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {Int32Field: 1},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "map_string_message_field.*.,map_string_message_field.*.string_field",
+		paths: []string{"map_string_message_field.*.string_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {StringField: "foo"},
+				"bar": {StringField: "bar"},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		mask:  "map_string_message_field.*.,map_string_message_field",
+		paths: []string{"map_string_message_field"},
+		msg:   msg,
+		out:   msg,
+	}.run(t)
+
+	for _, mask := range []string{
+		"map_string_message_field.",
+		"map_string_message_field.foo.int32_field.",
+		"int32_field.",
+		"message_field.",
+	} {
+		basicTest{
+			mask: mask,
+			err:  true,
+		}.run(t)
+	}
+}
+
+func TestUpdateMapDeletePrefix(t *testing.T) {
+	tombstones := WithUpdateMapDeletePrefix("~")
+	dst := &testpb.Message{
+		MapStringStringField: map[string]string{"a": "a", "b": "b", "c": "c"},
+		MapStringMessageField: map[string]*testpb.Message{
+			"a": {Int32Field: 1},
+			"b": {Int32Field: 2},
+		},
+		MapInt32StringField: map[int32]string{1: "1"},
+	}
+
+	updateTest{
+		name: "scalar-keyed",
+		mask: "map_string_string_field.a,map_string_string_field.b,map_string_string_field.d",
+		opts: []Option{tombstones},
+		dst:  dst,
+		src: &testpb.Message{
+			MapStringStringField: map[string]string{"~a": "", "b": "B", "~b": "", "~c": "", "d": "D"},
+		},
+		out: &testpb.Message{
+			MapStringStringField:  map[string]string{"c": "c", "d": "D"},
+			MapStringMessageField: dst.MapStringMessageField,
+			MapInt32StringField:   dst.MapInt32StringField,
+		},
+	}.run(t)
+
+	updateTest{
+		name: "scalar-complete",
+		mask: "map_string_string_field",
+		opts: []Option{tombstones},
+		dst:  dst,
+		src: &testpb.Message{
+			MapStringStringField: map[string]string{"a": "A", "~a": "", "b": "B", "~x": ""},
+		},
+		out: &testpb.Message{
+			MapStringStringField:  map[string]string{"b": "B"},
+			MapStringMessageField: dst.MapStringMessageField,
+			MapInt32StringField:   dst.MapInt32StringField,
+		},
+	}.run(t)
+
+	updateTest{
+		name: "message-wild",
+		mask: "map_string_message_field.*.int32_field",
+		opts: []Option{tombstones},
+		dst:  dst,
+		src: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"a":  {Int32Field: 10},
+				"~a": {},
+				"b":  {Int32Field: 20},
+			},
+		},
+		out: &testpb.Message{
+			MapStringStringField: dst.MapStringStringField,
+			MapStringMessageField: map[string]*testpb.Message{
+				"b": {Int32Field: 20},
+			},
+			MapInt32StringField: dst.MapInt32StringField,
+		},
+	}.run(t)
+
+	updateTest{
+		name: "message-complete",
+		mask: "map_string_message_field",
+		opts: []Option{tombstones},
+		dst:  dst,
+		src: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"~b": {},
+				"b":  {Int32Field: 20},
+				"c":  {Int32Field: 30},
+			},
+		},
+		out: &testpb.Message{
+			MapStringStringField: dst.MapStringStringField,
+			MapStringMessageField: map[string]*testpb.Message{
+				"c": {Int32Field: 30},
+			},
+			MapInt32StringField: dst.MapInt32StringField,
+		},
+	}.run(t)
+
+	updateTest{
+		name: "default",
+		mask: "map_string_string_field",
+		dst:  dst,
+		src: &testpb.Message{
+			MapStringStringField: map[string]string{"~a": ""},
+		},
+		out: &testpb.Message{
+			MapStringStringField:  map[string]string{"~a": ""},
+			MapStringMessageField: dst.MapStringMessageField,
+			MapInt32StringField:   dst.MapInt32StringField,
+		},
+	}.run(t)
+}
+
+func TestMapValueFieldFilter(t *testing.T) {
+	filter := WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool {
+		return fd.Name() != "string_field"
+	})
+	for _, tt := range []struct {
+		mask string
+		err  bool
+	}{
+		{mask: "map_string_message_field.*.int32_field"},
+		{mask: "map_string_message_field.foo.int32_field"},
+		{mask: "map_string_message_field.*"},
+		{mask: "map_string_message_field.foo."},
+		{mask: "string_field"},
+		{mask: "message_field.string_field"},
+		{mask: "map_string_message_field.*.string_field", err: true},
+		{mask: "map_string_message_field.foo.string_field", err: true},
+		{mask: "map_string_message_field.foo.message_field.string_field", err: true},
+		{mask: "map_string_message_field.foo.repeated_message_field.*.string_field", err: true},
+		{mask: "map_string_message_field.foo.int32_field,map_string_message_field.*.string_field", err: true},
+		{mask: "map_string_message_field.*.int32_field,map_string_message_field.foo.string_field", err: true},
+		{mask: "message_field.map_int32_message_field.1.string_field", err: true},
+	} {
+		t.Run(tt.mask, func(t *testing.T) {
+			_, err := Parse[*testpb.Message](tt.mask, filter)
+			if tt.err && err == nil {
+				t.Fatal("Parse: expected error")
+			}
+			if !tt.err && err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			// Without a filter, every path is valid.
+			if _, err := Parse[*testpb.Message](tt.mask); err != nil {
+				t.Fatalf("Parse: unexpected error without filter: %v", err)
+			}
+		})
+	}
+}
+
+func TestDropEmptyMapValues(t *testing.T) {
+	drop := WithDropEmptyMapValues(true)
+	msg := &testpb.Message{
+		MapStringMessageField: map[string]*testpb.Message{
+			"empty":  {},
+			"int32":  {Int32Field: 1},
+			"string": {StringField: "s"},
+			"both":   {Int32Field: 2, StringField: "t"},
+		},
+		MapStringStringField: map[string]string{
+			"empty": "",
+			"foo":   "foo",
+		},
+	}
+
+	basicTest{
+		name:  "complete",
+		mask:  "map_string_message_field.*",
+		opts:  []Option{drop},
+		paths: []string{"map_string_message_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"int32":  {Int32Field: 1},
+				"string": {StringField: "s"},
+				"both":   {Int32Field: 2, StringField: "t"},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		name:  "wild-submask",
+		mask:  "map_string_message_field.*.int32_field",
+		opts:  []Option{drop},
+		paths: []string{"map_string_message_field.*.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"int32": {Int32Field: 1},
+				"both":  {Int32Field: 2},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		name: "keyed-submask",
+		mask: "map_string_message_field.string.int32_field,map_string_message_field.both.int32_field",
+		opts: []Option{drop},
+		paths: []string{
+			"map_string_message_field.both.int32_field",
+			"map_string_message_field.string.int32_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"both": {Int32Field: 2},
+			},
+		},
+	}.run(t)
+
+	basicTest{
+		name:  "emptied",
+		mask:  "map_string_message_field.*.",
+		opts:  []Option{drop},
+		paths: []string{"map_string_message_field.*."},
+		msg:   msg,
+		out:   &testpb.Message{MapStringMessageField: map[string]*testpb.Message{}},
+	}.run(t)
+
+	basicTest{
+		name:  "scalar-noop",
+		mask:  "map_string_string_field",
+		opts:  []Option{drop},
+		paths: []string{"map_string_string_field"},
+		msg:   msg,
+		out:   &testpb.Message{MapStringStringField: msg.MapStringStringField},
+	}.run(t)
+
+	basicTest{
+		name:  "default",
+		mask:  "map_string_message_field.*.int32_field",
+		paths: []string{"map_string_message_field.*.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"empty":  {},
+				"int32":  {Int32Field: 1},
+				"string": {},
+				"both":   {Int32Field: 2},
+			},
+		},
+	}.run(t)
+
+	var cleared []string
+	fm, err := Parse[*testpb.Message]("map_string_message_field.*.int32_field", drop, WithOnClear(func(path string, _ protoreflect.FieldDescriptor) {
+		cleared = append(cleared, path)
+	}))
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	fm.Mask(clone(msg))
+	slices.Sort(cleared)
+	want := []string{
+		"map_string_message_field.both.string_field",
+		"map_string_message_field.empty",
+		"map_string_message_field.string",
+		"map_string_message_field.string.string_field",
+		"map_string_string_field",
+	}
+	if !slices.Equal(cleared, want) {
+		t.Errorf("OnClear: got: %q; want: %q", cleared, want)
+	}
+}
+
+func TestKeyedOverridesWild(t *testing.T) {
+	msg := &testpb.Message{
+		MapStringMessageField: map[string]*testpb.Message{
+			"foo": {Int32Field: 1, StringField: "foo", BoolField: true},
+			"bar": {Int32Field: 2, StringField: "bar", BoolField: true},
+		},
+	}
+	for _, mask := range []string{
+		"map_string_message_field.*.int32_field,map_string_message_field.foo.string_field",
+		"map_string_message_field.foo.string_field,map_string_message_field.*.int32_field",
+	} {
+		basicTest{
+			name: "additive:" + mask,
+			mask: mask,
+			paths: []string{
+				"map_string_message_field.*.int32_field",
+				"map_string_message_field.foo.string_field",
+			},
+			msg: msg,
+			out: &testpb.Message{
+				MapStringMessageField: map[string]*testpb.Message{
+					"foo": {Int32Field: 1, StringField: "foo"},
+					"bar": {Int32Field: 2},
+				},
+			},
+		}.run(t)
+		basicTest{
+			name: "override:" + mask,
+			mask: mask,
+			opts: []Option{WithKeyedOverridesWild(true)},
+			paths: []string{
+				"map_string_message_field.*.int32_field",
+				"map_string_message_field.foo.string_field",
+			},
+			msg: msg,
+			out: &testpb.Message{
+				MapStringMessageField: map[string]*testpb.Message{
+					"foo": {StringField: "foo"},
+					"bar": {Int32Field: 2},
+				},
+			},
+		}.run(t)
+	}
+
+	basicTest{
+		name: "override:shared",
+		mask: "map_string_message_field.*.int32_field,map_string_message_field.foo.int32_field",
+		opts: []Option{WithKeyedOverridesWild(true)},
+		paths: []string{
+			"map_string_message_field.*.int32_field",
+			"map_string_message_field.foo.int32_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {Int32Field: 1},
+				"bar": {Int32Field: 2},
+			},
+		},
+	}.run(t)
+	basicTest{
+		name:  "override:complete",
+		mask:  "map_string_message_field.*,map_string_message_field.foo.string_field",
+		opts:  []Option{WithKeyedOverridesWild(true)},
+		paths: []string{"map_string_message_field"},
+		msg:   msg,
+		out:   msg,
+	}.run(t)
+
+	updateTest{
+		name: "override:update",
+		mask: "map_string_message_field.*.int32_field,map_string_message_field.foo.string_field",
+		opts: []Option{WithKeyedOverridesWild(true)},
+		dst: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {Int32Field: 10, StringField: "old"},
+				"bar": {Int32Field: 20, StringField: "old"},
+			},
+		},
+		src: msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"foo": {Int32Field: 10, StringField: "foo"},
+				"bar": {Int32Field: 2, StringField: "old"},
+			},
+		},
+	}.run(t)
+}
+
+func TestMapKeys(t *testing.T) {
+	tests := []struct {
+		mask  string
+		opts  []Option
+		field string
+		keys  []string
+		all   bool
+		err   bool
+	}{
+		{mask: "map_string_string_field.foo,map_string_string_field.bar", field: "map_string_string_field", keys: []string{"bar", "foo"}},
+		{mask: "map_string_string_field.`a.b`", field: "map_string_string_field", keys: []string{"a.b"}},
+		{mask: "map_string_string_field", field: "map_string_string_field", all: true},
+		{mask: "map_string_string_field.*", field: "map_string_string_field", all: true},
+		{mask: "*", field: "map_string_string_field", all: true},
+		{mask: "int32_field", field: "map_string_string_field"},
+		{mask: "map_int32_message_field.3.int32_field,map_int32_message_field.-1.int32_field,map_int32_message_field.20.", field: "map_int32_message_field", keys: []string{"-1", "3", "20"}},
+		{mask: "map_int32_message_field.*.int32_field,map_int32_message_field.1.string_field", field: "map_int32_message_field", keys: []string{"1"}, all: true},
+		{mask: "map_bool_string_field.true", field: "map_bool_string_field", keys: []string{"true"}},
+		{mask: "map_bool_string_field.1", opts: []Option{WithBoolKeyStyle(NumericBoolKeys)}, field: "map_bool_string_field", keys: []string{"1"}},
+		{mask: "message_field.map_string_string_field.foo", field: "message_field.map_string_string_field", keys: []string{"foo"}},
+		{mask: "message_field", field: "message_field.map_string_string_field", all: true},
+		{mask: "message_field.int32_field", field: "message_field.map_string_string_field"},
+		{mask: "map_string_string_field.foo", opts: []Option{WithAlias(map[string]string{"m": "map_string_string_field"})}, field: "m", keys: []string{"foo"}},
+		{mask: "*", field: "int32_field", err: true},
+		{mask: "*", field: "unknown_field", err: true},
+		{mask: "*", field: "repeated_message_field.map_string_string_field", err: true},
+		{mask: "*", field: "", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mask+"/"+tt.field, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			keys, all, err := fm.MapKeys(tt.field)
+			if tt.err {
+				if err == nil {
+					t.Fatal("MapKeys: expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MapKeys: unexpected error: %v", err)
+			}
+			if !slices.Equal(keys, tt.keys) || all != tt.all {
+				t.Errorf("MapKeys: got: %q, %v; want: %q, %v", keys, all, tt.keys, tt.all)
+			}
+		})
+	}
+}
+
+func TestMapValueFieldNumbers(t *testing.T) {
+	for _, tt := range []pathTest{
+		{
+			name:  "keyed",
+			input: "map_string_message_field.foo.#3",
+			paths: []string{"map_string_message_field.foo.int32_field"},
+		},
+		{
+			name:  "wild",
+			input: "map_int32_message_field.*.#11.#2",
+			paths: []string{"map_int32_message_field.*.message_field.string_field"},
+		},
+		{
+			name:  "json",
+			input: "mapStringMessageField.foo.#3",
+			opts:  []Option{WithFieldName(JSONFieldName, true)},
+			paths: []string{"mapStringMessageField.foo.int32Field"},
+		},
+		{
+			name:  "numbered map",
+			input: "#502.`#3`.#3",
+			paths: []string{"map_string_message_field.#3.int32_field"},
+		},
+		{
+			name:  "unknown",
+			input: "map_string_message_field.foo.#999",
+			err:   true,
+		},
+		{
+			name:  "scalar",
+			input: "map_string_string_field.foo.#3",
+			err:   true,
+		},
+	} {
+		tt.run(t)
+	}
+
+	_, err := Parse[*testpb.Proto2Message]("map_string_message_field.foo.#21")
+	if err == nil {
+		t.Fatal("Parse: expected error")
+	}
+	if got, want := err.Error(), "dev.bursavich.fieldmask.test.Proto2Message field number 21 is reserved"; got != want {
+		t.Errorf("Parse: unexpected error:\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestFieldNumberAmbiguity(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		paths []string
+		err   string
+	}{
+		{
+			name: "message",
+			path: "3",
+			err:  `unknown dev.bursavich.fieldmask.test.Message field: "3"; a field number must be prefixed with "#", as in "#3"`,
+		},
+		{
+			name: "map value",
+			path: "map_int32_message_field.3.11",
+			err:  `unknown dev.bursavich.fieldmask.test.Message field: "11"; a field number must be prefixed with "#", as in "#11"`,
+		},
+		{
+			name:  "prefixed",
+			path:  "#3",
+			paths: []string{"int32_field"},
+		},
+		{
+			name:  "map keys",
+			path:  "map_int32_message_field.3.#11,map_string_string_field.3",
+			paths: []string{"map_int32_message_field.3.message_field", "map_string_string_field.3"},
+		},
+		{
+			name:  "quoted map key",
+			path:  "map_string_message_field.`#3`.#3",
+			paths: []string{"map_string_message_field.#3.int32_field"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.path)
+			if tt.err != "" {
+				if err == nil {
+					t.Fatal("Parse: expected error")
+				}
+				if got := err.Error(); got != tt.err {
+					t.Errorf("Parse: unexpected error:\ngot:  %s\nwant: %s", got, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			if got := fm.Paths(); !slices.Equal(got, tt.paths) {
+				t.Errorf("Paths: got: %q; want: %q", got, tt.paths)
+			}
+		})
+	}
+}
+
+func TestMapKeyTransform(t *testing.T) {
+	ids := map[string]string{"alice": "id-1", "bob": "id-2"}
+	slugs := map[string]string{"id-1": "alice", "id-2": "bob"}
+	transform := WithMapKeyTransform("map_string_message_field",
+		func(slug string) (string, error) {
+			if id, ok := ids[slug]; ok {
+				return id, nil
+			}
+			return "", fmt.Errorf("unknown slug: %q", slug)
+		},
+		func(id string) string { return slugs[id] },
+	)
+	msg := &testpb.Message{
+		MapStringMessageField: map[string]*testpb.Message{
+			"id-1": {Int32Field: 1, StringField: "a"},
+			"id-2": {Int32Field: 2},
+			"id-3": {Int32Field: 3},
+		},
+		MapStringStringField: map[string]string{"alice": "x", "id-1": "y"},
+	}
+	basicTest{
+		mask:  "map_string_message_field.alice.int32_field,map_string_message_field.bob,map_string_string_field.alice",
+		opts:  []Option{transform},
+		paths: []string{"map_string_message_field.alice.int32_field", "map_string_message_field.bob", "map_string_string_field.alice"},
+		msg:   msg,
+		out: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"id-1": {Int32Field: 1},
+				"id-2": {Int32Field: 2},
+			},
+			MapStringStringField: map[string]string{"alice": "x"},
+		},
+	}.run(t)
+
+	basicTest{
+		name: "unknown slug",
+		mask: "map_string_message_field.carol",
+		opts: []Option{transform},
+		err:  true,
+	}.run(t)
+
+	basicTest{
+		name: "untransformed key",
+		mask: "map_string_message_field.id-1",
+		opts: []Option{transform},
+		err:  true,
+	}.run(t)
+
+	if _, err := Parse[*testpb.Message]("*", WithMapKeyTransform("map_int32_string_field", nil, nil)); err == nil {
+		t.Error("Parse: expected error for a map field without string keys")
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.24.2
+// source: maskpb/mask.proto
+
+package maskpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_maskpb_mask_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         50231,
+		Name:          "dev.bursavich.fieldmask.default_mask",
+		Tag:           "bytes,50231,rep,name=default_mask",
+		Filename:      "maskpb/mask.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// The paths of the default read mask of the message.
+	//
+	// repeated string default_mask = 50231;
+	E_DefaultMask = &file_maskpb_mask_proto_extTypes[0]
+)
+
+var File_maskpb_mask_proto protoreflect.FileDescriptor
+
+var file_maskpb_mask_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x61, 0x73, 0x6b, 0x70, 0x62, 0x2f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x64, 0x65, 0x76, 0x2e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69,
+	0x63, 0x68, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x1a, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x44,
+	0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xb7, 0x88, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4d, 0x61, 0x73, 0x6b, 0x42, 0x20, 0x5a, 0x1e, 0x62, 0x75, 0x72, 0x73, 0x61, 0x76, 0x69, 0x63,
+	0x68, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6d, 0x61, 0x73, 0x6b, 0x2f,
+	0x6d, 0x61, 0x73, 0x6b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_maskpb_mask_proto_goTypes = []interface{}{
+	(*descriptorpb.MessageOptions)(nil), // 0: google.protobuf.MessageOptions
+}
+var file_maskpb_mask_proto_depIdxs = []int32{
+	0, // 0: dev.bursavich.fieldmask.default_mask:extendee -> google.protobuf.MessageOptions
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_maskpb_mask_proto_init() }
+func file_maskpb_mask_proto_init() {
+	if File_maskpb_mask_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_maskpb_mask_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_maskpb_mask_proto_goTypes,
+		DependencyIndexes: file_maskpb_mask_proto_depIdxs,
+		ExtensionInfos:    file_maskpb_mask_proto_extTypes,
+	}.Build()
+	File_maskpb_mask_proto = out.File
+	file_maskpb_mask_proto_rawDesc = nil
+	file_maskpb_mask_proto_goTypes = nil
+	file_maskpb_mask_proto_depIdxs = nil
+}
@@ -8,9 +8,12 @@ package fieldmask
 
 import (
 	"fmt"
+	pathpkg "path"
 	"sort"
+	"strings"
 
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -26,34 +29,91 @@ func newMsgFieldMask(settings *settings, desc protoreflect.FieldDescriptor) *msg
 		desc: desc,
 		msgMask: msgMask{
 			desc:     desc.Message(),
+			fldDescs: desc.Message().Fields(),
 			settings: settings,
 		},
 	}
 }
 
-func (fm *msgFieldMask) mask(parent protoreflect.Message, value protoreflect.Value) {
-	fm.msgMask.mask(value.Message())
+func (fm *msgFieldMask) mask(parent protoreflect.Message, value protoreflect.Value, path string, c *callState) {
+	fm.msgMask.mask(value.Message(), path, c)
 }
 
-func (fm *msgFieldMask) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
-	return protoreflect.ValueOfMessage(fm.msgMask.clone(value.Message()))
+func (fm *msgFieldMask) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
+	return protoreflect.ValueOfMessage(fm.msgMask.clone(value.Message(), c))
 }
 
-func (fm *msgFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
-	if !exists || !value.IsValid() {
-		parent.Clear(fm.desc)
+func (fm *msgFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
+	if fm.complete() {
+		// The whole message is named by the mask, so it's replaced.
+		if !exists || !value.IsValid() {
+			parent.Clear(fm.desc)
+			return
+		}
+		if c.updates(fm.settings).updateReplacesMessages {
+			parent.Clear(fm.desc)
+		}
+		fm.msgMask.update(parent.Mutable(fm.desc).Message(), value.Message(), c)
 		return
 	}
-	src := value.Message()
+	if c.updates(fm.settings).updateWrapperPresence && isWrapper(fm.msgMask.desc) {
+		// The presence of the wrapper is the signal, so it's cleared if it's absent
+		// and it's kept even if its value is the default value.
+		if !exists || !value.IsValid() {
+			parent.Clear(fm.desc)
+			return
+		}
+		fm.msgMask.update(parent.Mutable(fm.desc).Message(), value.Message(), c)
+		return
+	}
+	// Only subfields are named by the mask, so they're updated individually
+	// and any other fields in the destination message are left untouched.
+	had := parent.Has(fm.desc)
+	absent := !exists || !value.IsValid()
+	if absent {
+		if !had {
+			return // Nothing to clear
+		}
+		value = protoreflect.ValueOfMessage(parent.Get(fm.desc).Message().Type().Zero())
+	}
 	dst := parent.Mutable(fm.desc).Message()
-	fm.msgMask.update(dst, src)
+	fm.msgMask.update(dst, value.Message(), c)
+	if (!had || absent && c.updates(fm.settings).updatePrunesEmptyMessages) && proto.Size(dst.Interface()) == 0 {
+		// Don't leave behind an empty message that didn't exist before,
+		// or that was emptied because it doesn't exist in the source.
+		parent.Clear(fm.desc)
+	}
+}
+
+// isWrapper returns a value indicating if the message is one of the google.protobuf wrapper types,
+// such as google.protobuf.Int32Value.
+func isWrapper(desc protoreflect.MessageDescriptor) bool {
+	switch desc.FullName() {
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return true
+	default:
+		return false
+	}
+}
+
+func (fm *msgFieldMask) covers(value protoreflect.Value, path string) bool {
+	return fm.msgMask.covers(value.Message(), path)
+}
+
+func (fm *msgFieldMask) modifies(value protoreflect.Value) bool {
+	return fm.msgMask.modifies(value.Message())
 }
 
 type msgMask struct {
 	desc     protoreflect.MessageDescriptor
 	fldDescs protoreflect.FieldDescriptors
 	fields   map[string]fieldMask
+	anyMasks map[protoreflect.FullName]*msgMask
 	settings *settings
+	selects  bool // selects any field that isn't filtered
 }
 
 func newMsgMask(settings *settings, desc protoreflect.MessageDescriptor) *msgMask {
@@ -64,47 +124,108 @@ func newMsgMask(settings *settings, desc protoreflect.MessageDescriptor) *msgMas
 	}
 }
 
+// newEmptyMsgMask returns a mask that selects none of the message's fields.
+func newEmptyMsgMask(settings *settings, desc protoreflect.MessageDescriptor) *msgMask {
+	mm := newMsgMask(settings, desc)
+	mm.fields = make(map[string]fieldMask)
+	return mm
+}
+
 func (mm *msgMask) complete() bool { return mm.fields == nil }
 
 func (mm *msgMask) init(path string) error {
+	path = mm.expandStructPath(path)
+	if paths, ok, err := mm.expandGlob(path); ok {
+		if err != nil {
+			return err
+		}
+		if err := mm.init(paths[0]); err != nil {
+			return err
+		}
+		for _, path := range paths[1:] {
+			if err := mm.append(path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if path == "" || path == "*" {
 		return nil
 	}
-	name, subpath, err := nextSegment(path)
+	if ok, err := mm.addAnyPath(path, true); ok {
+		return err
+	}
+	key, fd, subpath, err := mm.lookupSegment(path)
 	if err != nil {
 		return err
 	}
-	key, fd, ok := mm.settings.lookupField(mm.fldDescs, name)
-	if !ok {
-		return fmt.Errorf("unknown %v field: %q", mm.desc.FullName(), name)
+	if err := mm.settings.checkDeprecated(fd); err != nil {
+		return err
+	}
+	if err := mm.settings.checkMapValueField(fd); err != nil {
+		return err
+	}
+	if err := mm.settings.enterField(fd); err != nil {
+		return err
+	}
+	defer mm.settings.leaveField(fd)
+	if err := mm.settings.enterMessage(mm.desc); err != nil {
+		return err
 	}
+	defer mm.settings.leaveMessage()
 	fld := newFieldMask(mm.settings, fd)
 	if err := fld.init(subpath); err != nil {
 		return err
 	}
-	mm.fields = map[string]fieldMask{
-		key: fld,
-	}
+	mm.fields = make(map[string]fieldMask)
+	mm.setField(key, fd, fld)
 	return nil
 }
 
 func (mm *msgMask) append(path string) error {
+	path = mm.expandStructPath(path)
+	if paths, ok, err := mm.expandGlob(path); ok {
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			if err := mm.append(path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if path == "" || path == "*" {
 		mm.fields = nil
+		mm.anyMasks = nil
+		mm.selects = false
 		return nil
 	}
-	name, subpath, err := nextSegment(path)
+	if ok, err := mm.addAnyPath(path, false); ok {
+		return err
+	}
+	key, fd, subpath, err := mm.lookupSegment(path)
 	if err != nil {
 		return err
 	}
-	key, fd, ok := mm.settings.lookupField(mm.fldDescs, name)
-	if !ok {
-		return fmt.Errorf("unknown %v field: %q", mm.desc.FullName(), name)
+	if err := mm.settings.checkDeprecated(fd); err != nil {
+		return err
+	}
+	if err := mm.settings.checkMapValueField(fd); err != nil {
+		return err
 	}
 	if mm.fields == nil {
 		// TODO: Validate the subpath.
 		return nil
 	}
+	if err := mm.settings.enterField(fd); err != nil {
+		return err
+	}
+	defer mm.settings.leaveField(fd)
+	if err := mm.settings.enterMessage(mm.desc); err != nil {
+		return err
+	}
+	defer mm.settings.leaveMessage()
 	if fld, ok := mm.fields[key]; ok {
 		return fld.append(subpath)
 	}
@@ -112,69 +233,282 @@ func (mm *msgMask) append(path string) error {
 	if err := fld.init(subpath); err != nil {
 		return err
 	}
-	mm.fields[key] = fld
+	mm.setField(key, fd, fld)
 	return nil
 }
 
-func (mm *msgMask) paths() []string {
+// setField sets the mask of the field with the given key and descriptor.
+func (mm *msgMask) setField(key string, fd protoreflect.FieldDescriptor, fld fieldMask) {
+	mm.fields[key] = fld
+	mm.selects = mm.selects || mm.settings.allow(fd)
+}
+
+func (mm *msgMask) coversPath(path string) bool {
+	if mm.complete() {
+		return true
+	}
+	path = mm.expandStructPath(path)
+	if paths, ok, err := mm.expandGlob(path); ok {
+		if err != nil {
+			return false
+		}
+		for _, path := range paths {
+			if !mm.coversPath(path) {
+				return false
+			}
+		}
+		return true
+	}
+	if path == "" || path == "*" {
+		return false
+	}
+	if segment, subpath, err := nextSegment(path, mm.settings.pathSep); err == nil {
+		if name, ok := anyTypeName(segment); ok {
+			sub, ok := mm.anyMasks[name]
+			return ok && sub.coversPath(subpath)
+		}
+	}
+	key, _, subpath, err := mm.lookupSegment(path)
+	if err != nil {
+		return false
+	}
+	fld, ok := mm.fields[key]
+	return ok && fld.coversPath(subpath)
+}
+
+// lookupSegment returns the key and descriptor of the field named by the first segment of the path,
+// and the subpath. A list slice at the end of the segment is moved to the beginning of the subpath.
+func (mm *msgMask) lookupSegment(path string) (key string, fd protoreflect.FieldDescriptor, subpath string, err error) {
+	name, subpath, err := nextSegment(path, mm.settings.pathSep)
+	if err != nil {
+		return "", nil, "", err
+	}
+	name, subpath, sliced := splitListSlice(name, subpath, mm.settings.pathSep)
+	key, fd, ok := mm.settings.lookupMessageField(mm.desc, mm.fldDescs, name)
+	if !ok {
+		return "", nil, "", mm.settings.unknownFieldError(mm.desc, name)
+	}
+	if sliced && !fd.IsList() && (fd.Message() == nil || fd.Message().FullName() != "google.protobuf.ListValue") {
+		return "", nil, "", fmt.Errorf("invalid list slice of %v field: %q is not a list", mm.desc.FullName(), name)
+	}
+	return key, fd, subpath, nil
+}
+
+// expandGlob returns the paths of the matching fields of the message and a value indicating if the
+// first segment of the path is a glob that's expanded, or else a nil error and a false value.
+func (mm *msgMask) expandGlob(path string) ([]string, bool, error) {
+	if !mm.settings.globExpansion || mm.structField() != nil {
+		return nil, false, nil
+	}
+	name, subpath, err := nextGlobSegment(path, mm.settings.pathSep)
+	if err != nil || name == "*" || name[0] == '`' || !strings.Contains(name, "*") {
+		return nil, false, nil
+	}
 	var paths []string
+	for i, n := 0, mm.fldDescs.Len(); i < n; i++ {
+		key := mm.settings.fieldKey(mm.fldDescs.Get(i))
+		ok, err := pathpkg.Match(name, key)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid glob: %q", name)
+		}
+		if !ok {
+			continue
+		}
+		if subpath != "" {
+			key = joinPath(key, subpath, mm.settings.pathSep)
+		}
+		paths = append(paths, key)
+	}
+	if len(paths) == 0 {
+		return nil, true, fmt.Errorf("no %v fields match glob: %q", mm.desc.FullName(), name)
+	}
+	return paths, true, nil
+}
+
+func (mm *msgMask) paths() []string {
+	paths := mm.anyPaths()
 	names := maps.Keys(mm.fields)
 	sort.Strings(names)
 	for _, names := range names {
 		subs := mm.fields[names].paths()
 		for _, sub := range subs {
-			paths = append(paths, joinPath(names, sub))
+			paths = append(paths, joinFieldPath(names, sub, mm.settings.pathSep))
 		}
 		if len(subs) == 0 {
 			paths = append(paths, names)
 		}
 	}
-	return paths
+	return mm.collapseStructPaths(paths)
 }
 
-func (mm *msgMask) mask(msg protoreflect.Message) {
+func (mm *msgMask) mask(msg protoreflect.Message, path string, c *callState) {
 	if mm.complete() {
+		mm.settings.filterMessage(msg, path, c)
+		return
+	}
+	if mm.maskAny(msg, path, c) {
+		return
+	}
+	if mm.settings.onClear == nil && !mm.selects {
+		// Every field would be cleared, so the message is reset outright.
+		var raw protoreflect.RawFields
+		if mm.settings.maskUnknowns != MaskRetainsUnknowns || mm.settings.dropUnknowns[mm.desc.FullName()] {
+			raw = mm.settings.maskedUnknowns(mm.desc, msg.GetUnknown())
+		} else {
+			raw = copyBytes(msg.GetUnknown())
+		}
+		proto.Reset(msg.Interface())
+		if len(raw) > 0 {
+			msg.SetUnknown(raw)
+		}
 		return
 	}
 	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
-		if f, ok := mm.fields[string(fd.Name())]; ok && mm.settings.allow(fd) {
-			f.mask(msg, val)
+		c.check()
+		key := mm.settings.fieldKey(fd)
+		if f, ok := mm.fields[key]; ok && mm.settings.allow(fd) && mm.settings.allowValue(fd, val) {
+			f.mask(msg, val, mm.fieldPath(path, key), c)
 			return true
 		}
 		msg.Clear(fd)
+		mm.settings.cleared(path, key, fd)
 		return true
 	})
-	if mm.settings.maskUnknowns != MaskRetainsUnknowns {
-		msg.SetUnknown(nil)
+	if mm.settings.maskUnknowns != MaskRetainsUnknowns || mm.settings.dropUnknowns[mm.desc.FullName()] {
+		msg.SetUnknown(mm.settings.maskedUnknowns(mm.desc, msg.GetUnknown()))
 	}
 }
 
-func (mm *msgMask) clone(msg protoreflect.Message) protoreflect.Message {
+// fieldPath returns the path of the field with the given key, if there's an OnClear callback.
+// The field of a google.protobuf.Struct, Value, or ListValue message shares the message's path.
+func (mm *msgMask) fieldPath(path, key string) string {
+	if mm.structField() != nil {
+		return path
+	}
+	return mm.settings.clearPath(path, key)
+}
+
+func (mm *msgMask) clone(msg protoreflect.Message, c *callState) protoreflect.Message {
 	out := msg.New()
+	mm.cloneInto(out, msg, c)
+	return out
+}
+
+// cloneInto copies the masked fields of the message into the empty output message.
+func (mm *msgMask) cloneInto(out, msg protoreflect.Message, c *callState) {
 	if mm.complete() {
-		mm.settings.copyMessage(out, msg)
-		return out
+		mm.settings.copyMessage(out, msg, c)
+		return
 	}
-	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
-		if f, ok := mm.fields[string(fd.Name())]; ok && mm.settings.allow(fd) {
-			out.Set(fd, f.clone(msg, val))
+	if mm.cloneAny(out, msg, c) {
+		return
+	}
+	mm.settings.rangeFields(msg, func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		c.check()
+		if f, ok := mm.fields[mm.settings.fieldKey(fd)]; ok && mm.settings.allow(fd) && mm.settings.allowValue(fd, val) {
+			if v := f.clone(msg, val, c); !mm.settings.omitEmpty(fd, v) {
+				out.Set(fd, v)
+			}
 		}
 		return true
 	})
-	if mm.settings.maskUnknowns == MaskRetainsUnknowns {
-		out.SetUnknown(copyBytes(msg.GetUnknown()))
+	if raw := mm.settings.maskedUnknowns(mm.desc, msg.GetUnknown()); len(raw) > 0 {
+		out.SetUnknown(raw)
 	}
-	return out
 }
 
-func (mm *msgMask) update(dst, src protoreflect.Message) {
+func (mm *msgMask) update(dst, src protoreflect.Message, c *callState) {
 	if mm.complete() {
-		mm.settings.updateMessage(dst, src)
+		mm.settings.updateMessage(dst, src, c)
+		return
+	}
+	if mm.updateAny(dst, src, c) {
 		return
 	}
 	for name, mask := range mm.fields {
-		_, fd, _ := mm.settings.lookupField(mm.fldDescs, name)
-		mask.update(dst, src.Get(fd), src.Has(fd))
+		c.check()
+		_, fd, _ := mm.settings.lookupMessageField(mm.desc, mm.fldDescs, name)
+		if !mm.settings.allow(fd) {
+			continue
+		}
+		if _, ok := mask.(*msgFieldMask); ok && src.Has(fd) {
+			prev := c.pushPath(mm.settings, name)
+			mask.update(dst, src.Get(fd), true, c)
+			c.popPath(prev)
+			continue
+		}
+		c.untrack()
+		mask.update(dst, src.Get(fd), src.Has(fd), c)
+		c.track()
+		mm.settings.updatedField(fd, src.Get(fd), src.Has(fd), c)
+	}
+	mm.settings.doUpdateUnknowns(dst, src, c)
+}
+
+// absentPaths appends the paths of the fields named by the mask that are absent in the message.
+// It recurses into the message fields that are named in part. Their paths are relative to the
+// given path of the message.
+func (mm *msgMask) absentPaths(paths []string, msg protoreflect.Message, path string) []string {
+	names := maps.Keys(mm.fields)
+	sort.Strings(names)
+	for _, key := range names {
+		_, fd, _ := mm.settings.lookupMessageField(mm.desc, mm.fldDescs, key)
+		if !mm.settings.allow(fd) {
+			continue
+		}
+		name := key
+		if path != "" {
+			name = joinPath(path, key, mm.settings.pathSep)
+		}
+		if !msg.Has(fd) {
+			paths = append(paths, name)
+			continue
+		}
+		if f, ok := mm.fields[key].(*msgFieldMask); ok && !f.complete() {
+			paths = f.msgMask.absentPaths(paths, msg.Get(fd).Message(), name)
+		}
+	}
+	return paths
+}
+
+func (mm *msgMask) covers(msg protoreflect.Message, path string) bool {
+	if path == "" {
+		return true
+	}
+	if covered, ok := mm.coversAny(msg, path); ok {
+		return covered
+	}
+	key, fd, subpath, err := mm.lookupSegment(mm.expandStructPath(path))
+	if err != nil || !mm.settings.allow(fd) || !msg.Has(fd) {
+		return false
+	}
+	if mm.complete() {
+		// A new field mask covers the whole value.
+		return newFieldMask(mm.settings, fd).covers(msg.Get(fd), subpath)
+	}
+	f, ok := mm.fields[key]
+	return ok && f.covers(msg.Get(fd), subpath)
+}
+
+func (mm *msgMask) modifies(msg protoreflect.Message) bool {
+	if mm.complete() {
+		return mm.settings.filtersMessage(msg)
+	}
+	if sub, packed, ok := mm.anyMask(msg); ok {
+		return packed != nil && sub.modifies(packed)
+	}
+	modified := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		f, ok := mm.fields[mm.settings.fieldKey(fd)]
+		modified = !ok || !mm.settings.allow(fd) || !mm.settings.allowValue(fd, val) || f.modifies(val)
+		return !modified
+	})
+	if modified {
+		return true
+	}
+	if mm.settings.maskUnknowns != MaskRetainsUnknowns || mm.settings.dropUnknowns[mm.desc.FullName()] {
+		raw := msg.GetUnknown()
+		return len(mm.settings.maskedUnknowns(mm.desc, raw)) != len(raw)
 	}
-	mm.settings.doUpdateUnknowns(dst, src)
+	return false
 }
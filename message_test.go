@@ -7,9 +7,19 @@
 package fieldmask
 
 import (
+	"bytes"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestMessage(t *testing.T) {
@@ -113,3 +123,1317 @@ func TestMessage(t *testing.T) {
 		out: testMsg,
 	}.run(t)
 }
+
+func TestRetainUnknownNumbers(t *testing.T) {
+	var keep, drop []byte
+	keep = protowire.AppendTag(keep, 1000, protowire.VarintType)
+	keep = protowire.AppendVarint(keep, 42)
+	drop = protowire.AppendTag(drop, 1001, protowire.BytesType)
+	drop = protowire.AppendBytes(drop, []byte("drop"))
+
+	newMsg := func() *testpb.Message {
+		msg := &testpb.Message{Int32Field: 1, StringField: "foo"}
+		msg.ProtoReflect().SetUnknown(append(append(append([]byte(nil), drop...), keep...), drop...))
+		return msg
+	}
+	for _, mask := range []string{"*", "int32_field"} {
+		t.Run(mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](mask, WithRetainUnknownNumbers(1000))
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			if mask != "*" {
+				masked := newMsg()
+				fm.Mask(masked)
+				if got := masked.ProtoReflect().GetUnknown(); !bytes.Equal(got, keep) {
+					t.Errorf("Mask: unexpected unknowns: got: %x; want: %x", got, keep)
+				}
+			}
+			cloned := fm.Clone(newMsg())
+			if got := cloned.ProtoReflect().GetUnknown(); !bytes.Equal(got, keep) {
+				t.Errorf("Clone: unexpected unknowns: got: %x; want: %x", got, keep)
+			}
+		})
+	}
+}
+
+func TestFieldFilter(t *testing.T) {
+	filter := WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool {
+		return fd.Name() != "string_field"
+	})
+	msg := &testpb.Message{
+		Int32Field:  1,
+		StringField: "foo",
+		MessageField: &testpb.Message{
+			Int32Field:  2,
+			StringField: "bar",
+		},
+	}
+
+	basicTest{
+		name: "mask",
+		mask: "int32_field,string_field,message_field.string_field",
+		opts: []Option{filter},
+		paths: []string{
+			"int32_field",
+			"message_field.string_field",
+			"string_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			Int32Field:   1,
+			MessageField: &testpb.Message{},
+		},
+	}.run(t)
+
+	basicTest{
+		name:  "wildcard",
+		mask:  "message_field",
+		opts:  []Option{filter},
+		paths: []string{"message_field"},
+		msg:   &testpb.Message{MessageField: msg},
+		out: &testpb.Message{
+			MessageField: &testpb.Message{
+				Int32Field:   1,
+				MessageField: &testpb.Message{Int32Field: 2},
+			},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "update",
+		mask: "int32_field,string_field",
+		opts: []Option{filter},
+		dst:  &testpb.Message{StringField: "dst"},
+		src:  msg,
+		out: &testpb.Message{
+			Int32Field:  1,
+			StringField: "dst",
+		},
+	}.run(t)
+}
+
+func TestOneofClone(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field: 1,
+		OneofField: &testpb.Message_StringOneofField{StringOneofField: "foo"},
+	}
+	msgOneof := &testpb.Message{
+		OneofField: &testpb.Message_MessageOneofField{MessageOneofField: &testpb.Message{Int32Field: 2, StringField: "bar"}},
+	}
+	for _, tt := range []basicTest{
+		{
+			name:  "set",
+			mask:  "string_oneof_field",
+			paths: []string{"string_oneof_field"},
+			msg:   msg,
+			out:   &testpb.Message{OneofField: msg.OneofField},
+		},
+		{
+			name:  "unset",
+			mask:  "int32_oneof_field",
+			paths: []string{"int32_oneof_field"},
+			msg:   msg,
+			out:   &testpb.Message{},
+		},
+		{
+			name:  "members",
+			mask:  "bool_oneof_field,string_oneof_field,message_oneof_field.int32_field",
+			paths: []string{"bool_oneof_field", "message_oneof_field.int32_field", "string_oneof_field"},
+			msg:   msg,
+			out:   &testpb.Message{OneofField: msg.OneofField},
+		},
+		{
+			name:  "message",
+			mask:  "string_oneof_field,message_oneof_field.int32_field",
+			paths: []string{"message_oneof_field.int32_field", "string_oneof_field"},
+			msg:   msgOneof,
+			out: &testpb.Message{
+				OneofField: &testpb.Message_MessageOneofField{MessageOneofField: &testpb.Message{Int32Field: 2}},
+			},
+		},
+		{
+			name:  "unset message",
+			mask:  "message_oneof_field.int32_field",
+			paths: []string{"message_oneof_field.int32_field"},
+			msg:   msg,
+			out:   &testpb.Message{},
+		},
+	} {
+		tt.run(t)
+	}
+
+	// A member that's set to its default value is still set.
+	p2 := &testpb.Proto2Message{OneofField: &testpb.Proto2Message_Int32OneofField{Int32OneofField: 0}}
+	fm, err := Parse[*testpb.Proto2Message]("int32_oneof_field,message_oneof_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	out := fm.Clone(p2)
+	fd := out.ProtoReflect().Descriptor().Oneofs().ByName("oneof_field")
+	if got := out.ProtoReflect().WhichOneof(fd); got == nil || got.Name() != "int32_oneof_field" {
+		t.Errorf("Clone: got oneof member %v; want int32_oneof_field", got)
+	}
+}
+
+func TestMaskFilteredFields(t *testing.T) {
+	filter := WithFieldFilter(func(fd protoreflect.FieldDescriptor) bool {
+		return fd.Name() != "string_field"
+	})
+	unknown := protowire.AppendTag(nil, 9999, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	nested := &testpb.Message{Int32Field: 2, StringField: "bar"}
+	nested.ProtoReflect().SetUnknown(unknown)
+	msg := &testpb.Message{Int32Field: 1, MessageField: nested}
+	retained := &testpb.Message{}
+	retained.ProtoReflect().SetUnknown(unknown)
+
+	for _, tt := range []struct {
+		name string
+		opts []Option
+		want *testpb.Message
+	}{
+		{
+			name: "removes unknowns",
+			want: &testpb.Message{MessageField: &testpb.Message{}},
+		},
+		{
+			name: "retains unknowns",
+			opts: []Option{WithMaskUnknowns(MaskRetainsUnknowns)},
+			want: &testpb.Message{MessageField: retained},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("message_field.string_field", append(tt.opts, filter)...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			got := clone(msg)
+			fm.Mask(got)
+			if diff := protoDiff(tt.want, got); diff != "" {
+				t.Fatalf("Mask: unexpected diff:\n%s", diff)
+			}
+
+			dyn := dynamicpb.NewMessage(msg.ProtoReflect().Descriptor())
+			proto.Merge(dyn, msg)
+			dfm, err := Parse[*dynamicpb.Message]("message_field.string_field", append(tt.opts, filter, WithMessageDescriptor(dyn.Descriptor()))...)
+			if err != nil {
+				t.Fatalf("Failed to parse dynamic mask: %v", err)
+			}
+			dfm.Mask(dyn)
+			b, err := proto.Marshal(dyn)
+			if err != nil {
+				t.Fatalf("Failed to marshal dynamic message: %v", err)
+			}
+			got = &testpb.Message{}
+			if err := proto.Unmarshal(b, got); err != nil {
+				t.Fatalf("Failed to unmarshal dynamic message: %v", err)
+			}
+			if diff := protoDiff(tt.want, got); diff != "" {
+				t.Fatalf("Mask dynamic: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+
+	var cleared []string
+	onClear := WithOnClear(func(path string, fd protoreflect.FieldDescriptor) { cleared = append(cleared, path) })
+	fm, err := Parse[*testpb.Message]("message_field.string_field", filter, onClear)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	fm.Mask(clone(msg))
+	slices.Sort(cleared)
+	if want := []string{"int32_field", "message_field.int32_field", "message_field.string_field"}; !slices.Equal(cleared, want) {
+		t.Errorf("OnClear: got %q; want %q", cleared, want)
+	}
+}
+
+func TestProto2Presence(t *testing.T) {
+	msg := &testpb.Proto2Message{
+		BoolField:   proto.Bool(false),
+		StringField: proto.String(""),
+		Int32Field:  proto.Int32(0),
+		Int64Field:  proto.Int64(64),
+		BytesField:  []byte{},
+		MessageField: &testpb.Proto2Message{
+			Int32Field: proto.Int32(7),
+			Int64Field: proto.Int64(0),
+		},
+		OneofField: &testpb.Proto2Message_Int32OneofField{Int32OneofField: 0},
+	}
+	fields := msg.ProtoReflect().Descriptor().Fields()
+
+	for _, mask := range []string{
+		"*",
+		"bool_field,string_field,int32_field,int64_field,bytes_field,message_field,int32_oneof_field",
+		"bool_field,string_field,int32_field,int64_field,bytes_field,message_field.int32_field,message_field.int64_field,int32_oneof_field",
+	} {
+		t.Run(mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Proto2Message](mask)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			masked := clone(msg)
+			fm.Mask(masked)
+			cloned := fm.Clone(msg)
+			updated := &testpb.Proto2Message{}
+			if err := fm.Update(updated, msg); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			for name, out := range map[string]*testpb.Proto2Message{
+				"Mask":   masked,
+				"Clone":  cloned,
+				"Update": updated,
+			} {
+				if diff := protoDiff(msg, out); diff != "" {
+					t.Errorf("%s: unexpected diff:\n%s", name, diff)
+				}
+				for i := 0; i < fields.Len(); i++ {
+					fd := fields.Get(i)
+					if got, want := out.ProtoReflect().Has(fd), msg.ProtoReflect().Has(fd); got != want {
+						t.Errorf("%s: unexpected presence of %s: got: %v; want: %v", name, fd.Name(), got, want)
+					}
+				}
+			}
+		})
+	}
+
+	runUpdate := func(name, mask string, src, want *testpb.Proto2Message) {
+		t.Run(name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Proto2Message](mask)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := clone(msg)
+			if err := fm.Update(dst, src); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if diff := protoDiff(want, dst); diff != "" {
+				t.Fatalf("Update: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+	runUpdate("clear-unset", "int32_field,int64_field", &testpb.Proto2Message{}, func() *testpb.Proto2Message {
+		out := clone(msg)
+		out.Int32Field = nil
+		out.Int64Field = nil
+		return out
+	}())
+	runUpdate("set-zero", "message_field.int32_field", &testpb.Proto2Message{
+		MessageField: &testpb.Proto2Message{Int32Field: proto.Int32(0)},
+	}, func() *testpb.Proto2Message {
+		out := clone(msg)
+		out.MessageField.Int32Field = proto.Int32(0)
+		return out
+	}())
+}
+
+func TestUpdateMergesUnknowns(t *testing.T) {
+	varint := func(num protowire.Number, v uint64) []byte {
+		b := protowire.AppendTag(nil, num, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+	concat := func(bs ...[]byte) []byte {
+		var out []byte
+		for _, b := range bs {
+			out = append(out, b...)
+		}
+		return out
+	}
+	newMsg := func(unknowns []byte) *testpb.Message {
+		msg := &testpb.Message{}
+		msg.ProtoReflect().SetUnknown(unknowns)
+		return msg
+	}
+
+	for _, tt := range []struct {
+		name     string
+		dst, src []byte
+		want     []byte
+	}{
+		{
+			name: "singular",
+			dst:  concat(varint(1000, 1), varint(1001, 2)),
+			src:  varint(1000, 3),
+			want: concat(varint(1001, 2), varint(1000, 3)),
+		},
+		{
+			name: "repeated-dst",
+			dst:  concat(varint(1000, 1), varint(1000, 2)),
+			src:  varint(1000, 3),
+			want: concat(varint(1000, 1), varint(1000, 2), varint(1000, 3)),
+		},
+		{
+			name: "repeated-src",
+			dst:  varint(1000, 1),
+			src:  concat(varint(1000, 2), varint(1000, 3)),
+			want: concat(varint(1000, 1), varint(1000, 2), varint(1000, 3)),
+		},
+		{
+			name: "empty-src",
+			dst:  varint(1000, 1),
+			want: varint(1000, 1),
+		},
+		{
+			name: "malformed",
+			dst:  []byte{0xff},
+			src:  varint(1000, 1),
+			want: concat([]byte{0xff}, varint(1000, 1)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message]("int32_field", WithUpdateUnknowns(UpdateMergesUnknowns))
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := newMsg(tt.dst)
+			if err := fm.Update(dst, newMsg(tt.src)); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if got := dst.ProtoReflect().GetUnknown(); !bytes.Equal(got, tt.want) {
+				t.Errorf("Update: unexpected unknowns: got: %x; want: %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDropUnknownsFor(t *testing.T) {
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, 1000, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 42)
+
+	newMsg := func() *testpb.Message {
+		msg := &testpb.Message{
+			Int32Field:   1,
+			MessageField: &testpb.Message{Int32Field: 2},
+		}
+		msg.ProtoReflect().SetUnknown(copyBytes(unknown))
+		msg.MessageField.ProtoReflect().SetUnknown(copyBytes(unknown))
+		return msg
+	}
+	for _, tt := range []struct {
+		name string
+		drop protoreflect.FullName
+		want []byte
+	}{
+		{
+			name: "match",
+			drop: (&testpb.Message{}).ProtoReflect().Descriptor().FullName(),
+		},
+		{
+			name: "mismatch",
+			drop: (&testpb.Proto2Message{}).ProtoReflect().Descriptor().FullName(),
+			want: unknown,
+		},
+	} {
+		for _, mask := range []string{"int32_field,message_field", "int32_field,message_field.int32_field"} {
+			t.Run(tt.name+":"+mask, func(t *testing.T) {
+				fm, err := Parse[*testpb.Message](mask, WithMaskUnknowns(MaskRetainsUnknowns), WithDropUnknownsFor(tt.drop))
+				if err != nil {
+					t.Fatalf("Failed to parse mask: %v", err)
+				}
+				masked := newMsg()
+				fm.Mask(masked)
+				for name, out := range map[string]*testpb.Message{
+					"Mask":  masked,
+					"Clone": fm.Clone(newMsg()),
+				} {
+					if got := out.ProtoReflect().GetUnknown(); !bytes.Equal(got, tt.want) {
+						t.Errorf("%s: unexpected root unknowns: got: %x; want: %x", name, got, tt.want)
+					}
+					if got := out.MessageField.ProtoReflect().GetUnknown(); !bytes.Equal(got, tt.want) {
+						t.Errorf("%s: unexpected nested unknowns: got: %x; want: %x", name, got, tt.want)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestAIP134 checks the update semantics described by https://google.aip.dev/134.
+func TestAIP134(t *testing.T) {
+	dst := &testpb.Message{
+		Int32Field:  1,
+		StringField: "dst",
+		MessageField: &testpb.Message{
+			Int32Field:  2,
+			StringField: "dst",
+		},
+	}
+
+	// Fields named by the mask are replaced and other fields are left untouched.
+	updateTest{
+		name: "leaf",
+		mask: "int32_field",
+		dst:  dst,
+		src:  &testpb.Message{Int32Field: 10, StringField: "src"},
+		out: &testpb.Message{
+			Int32Field:   10,
+			StringField:  "dst",
+			MessageField: dst.MessageField,
+		},
+	}.run(t)
+
+	// Fields named by the mask but not set in the resource are cleared.
+	updateTest{
+		name: "leaf-unset",
+		mask: "int32_field",
+		dst:  dst,
+		src:  &testpb.Message{StringField: "src"},
+		out: &testpb.Message{
+			StringField:  "dst",
+			MessageField: dst.MessageField,
+		},
+	}.run(t)
+
+	// A subfield is updated without replacing the rest of its parent message.
+	updateTest{
+		name: "subfield",
+		mask: "message_field.int32_field",
+		dst:  dst,
+		src:  &testpb.Message{MessageField: &testpb.Message{Int32Field: 20, StringField: "src"}},
+		out: &testpb.Message{
+			Int32Field:   1,
+			StringField:  "dst",
+			MessageField: &testpb.Message{Int32Field: 20, StringField: "dst"},
+		},
+	}.run(t)
+
+	// A subfield is cleared without clearing the rest of its parent message,
+	// even if the parent message isn't set in the resource.
+	updateTest{
+		name: "subfield-parent-unset",
+		mask: "message_field.int32_field",
+		dst:  dst,
+		src:  &testpb.Message{},
+		out: &testpb.Message{
+			Int32Field:   1,
+			StringField:  "dst",
+			MessageField: &testpb.Message{StringField: "dst"},
+		},
+	}.run(t)
+
+	// A subfield's parent message is created if the subfield is set in the resource.
+	updateTest{
+		name: "subfield-parent-created",
+		mask: "message_field.int32_field",
+		dst:  &testpb.Message{},
+		src:  &testpb.Message{MessageField: &testpb.Message{Int32Field: 20, StringField: "src"}},
+		out: &testpb.Message{
+			MessageField: &testpb.Message{Int32Field: 20},
+		},
+	}.run(t)
+
+	// A subfield's parent message isn't created if the subfield isn't set in the resource.
+	updateTest{
+		name: "subfield-parent-not-created",
+		mask: "message_field.int32_field",
+		dst:  &testpb.Message{},
+		src:  &testpb.Message{MessageField: &testpb.Message{StringField: "src"}},
+		out:  &testpb.Message{},
+	}.run(t)
+
+	// A message field named by the mask is replaced entirely.
+	updateTest{
+		name: "message",
+		mask: "message_field",
+		dst:  dst,
+		src:  &testpb.Message{MessageField: &testpb.Message{Int32Field: 20}},
+		out: &testpb.Message{
+			Int32Field:   1,
+			StringField:  "dst",
+			MessageField: &testpb.Message{Int32Field: 20},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "message-unset",
+		mask: "message_field",
+		dst:  dst,
+		src:  &testpb.Message{},
+		out: &testpb.Message{
+			Int32Field:  1,
+			StringField: "dst",
+		},
+	}.run(t)
+
+	// A wildcard replaces the entire resource.
+	updateTest{
+		name: "wildcard",
+		mask: "*",
+		dst:  dst,
+		src:  &testpb.Message{StringField: "src"},
+		out:  &testpb.Message{StringField: "src"},
+	}.run(t)
+}
+
+func TestDeprecatedFields(t *testing.T) {
+	for _, mask := range []string{
+		"deprecated_field",
+		"int32_field,deprecated_field",
+		"message_field.deprecated_field",
+		"map_string_message_field.foo.deprecated_field",
+	} {
+		if _, err := Parse[*testpb.Proto2Message](mask); err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", mask, err)
+		}
+		if _, err := Parse[*testpb.Proto2Message](mask, WithRejectDeprecated(true)); err == nil {
+			t.Errorf("Parse(%q): expected error for deprecated field", mask)
+		}
+		var warned []protoreflect.FullName
+		_, err := Parse[*testpb.Proto2Message](mask, WithWarnDeprecated(func(fd protoreflect.FieldDescriptor) {
+			warned = append(warned, fd.FullName())
+		}))
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", mask, err)
+		}
+		if want := protoreflect.FullName("dev.bursavich.fieldmask.test.Proto2Message.deprecated_field"); len(warned) != 1 || warned[0] != want {
+			t.Errorf("Parse(%q): unexpected warnings: got: %v; want: [%v]", mask, warned, want)
+		}
+	}
+
+	if _, err := Parse[*testpb.Proto2Message]("int32_field,message_field", WithRejectDeprecated(true)); err != nil {
+		t.Errorf("Parse: unexpected error: %v", err)
+	}
+}
+
+// A setOrderMessage records the numbers of the fields set in a message.
+type setOrderMessage struct {
+	protoreflect.Message
+	nums *[]protoreflect.FieldNumber
+}
+
+func (m setOrderMessage) Set(fd protoreflect.FieldDescriptor, val protoreflect.Value) {
+	*m.nums = append(*m.nums, fd.Number())
+	m.Message.Set(fd, val)
+}
+
+func (m setOrderMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	*m.nums = append(*m.nums, fd.Number())
+	return m.Message.Mutable(fd)
+}
+
+func TestDeterministicFieldOrder(t *testing.T) {
+	desc := testMsg.ProtoReflect().Descriptor()
+	b, err := proto.Marshal(testMsg)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+	// Dynamic messages range over their fields in map order.
+	src := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(b, src); err != nil {
+		t.Fatalf("Failed to unmarshal message: %v", err)
+	}
+	for _, mask := range []string{"*", "int32_field,string_field,message_field,repeated_int32_field,map_string_string_field,bytes_field"} {
+		fm, err := Parse[*dynamicpb.Message](mask, WithMessageDescriptor(desc), WithDeterministicFieldOrder(true))
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %v", err)
+		}
+		var nums []protoreflect.FieldNumber
+		out := setOrderMessage{Message: dynamicpb.NewMessage(desc), nums: &nums}
+		fm.msg.cloneInto(out, src, nil)
+		if len(nums) < 2 {
+			t.Fatalf("Clone(%q): too few fields set: %v", mask, nums)
+		}
+		if !slices.IsSorted(nums) {
+			t.Errorf("Clone(%q): fields set out of order: %v", mask, nums)
+		}
+		if want := fm.Clone(src); !proto.Equal(want, out.Interface()) {
+			t.Errorf("Clone(%q): unexpected clone", mask)
+		}
+	}
+}
+
+func TestOmitEmptyContainers(t *testing.T) {
+	positive := func(key protoreflect.MapKey) bool { return key.Int() > 0 }
+	for _, tt := range []struct {
+		name string
+		mask string
+		opts []Option
+		msg  *testpb.Message
+	}{
+		{
+			name: "keyed-absent",
+			mask: "map_string_string_field.foo,repeated_message_field.*.int32_field",
+			msg:  &testpb.Message{},
+		},
+		{
+			name: "keyed-present-empty",
+			mask: "map_string_string_field.foo",
+			msg:  &testpb.Message{MapStringStringField: map[string]string{"bar": "bar"}},
+		},
+		{
+			name: "indexed",
+			mask: "repeated_message_field.2.int32_field",
+			msg:  &testpb.Message{RepeatedMessageField: []*testpb.Message{{Int32Field: 1}}},
+		},
+		{
+			name: "filtered",
+			mask: "map_int32_string_field",
+			opts: []Option{WithMapKeyFilter("map_int32_string_field", positive)},
+			msg:  &testpb.Message{MapInt32StringField: map[int32]string{-1: "neg"}},
+		},
+		{
+			name: "filtered-complete",
+			mask: "*",
+			opts: []Option{WithMapKeyFilter("map_int32_string_field", positive)},
+			msg:  &testpb.Message{MapInt32StringField: map[int32]string{-1: "neg"}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, append(tt.opts, WithOmitEmptyContainers(true))...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			out := fm.Clone(tt.msg)
+			if out.MapStringStringField != nil || out.MapInt32StringField != nil || out.RepeatedMessageField != nil {
+				t.Errorf("Clone: unexpected empty containers: %v", out)
+			}
+		})
+	}
+
+	fm, err := Parse[*testpb.Message]("map_string_string_field.foo")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if out := fm.Clone(&testpb.Message{MapStringStringField: map[string]string{"bar": "bar"}}); out.MapStringStringField == nil {
+		t.Error("Clone: expected empty map by default")
+	}
+}
+
+func TestRejectRecursion(t *testing.T) {
+	for _, tt := range []struct {
+		mask string
+		err  bool
+	}{
+		{mask: "int32_field"},
+		{mask: "message_field"},
+		{mask: "repeated_message_field"},
+		{mask: "map_string_message_field.foo"},
+		{mask: "int32_field,message_field,map_string_message_field"},
+		{mask: "message_field.int32_field", err: true},
+		{mask: "message_field.message_field.message_field", err: true},
+		{mask: "int32_field,message_field.int32_field", err: true},
+		{mask: "repeated_message_field.*.int32_field", err: true},
+		{mask: "map_string_message_field.*.int32_field", err: true},
+		{mask: "map_string_message_field.foo.int32_field", err: true},
+	} {
+		if _, err := Parse[*testpb.Message](tt.mask); err != nil {
+			t.Errorf("Parse(%q): unexpected error by default: %v", tt.mask, err)
+		}
+		_, err := Parse[*testpb.Message](tt.mask, WithRejectRecursion(true))
+		if tt.err && err == nil {
+			t.Errorf("Parse(%q): expected error", tt.mask)
+		} else if !tt.err && err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tt.mask, err)
+		}
+	}
+
+	fm, err := Parse[*testpb.Message]("int32_field", WithRejectRecursion(true))
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := fm.Append("message_field.int32_field"); err == nil {
+			t.Errorf("Append: expected error")
+		}
+		if err := fm.Append("string_field"); err != nil {
+			t.Errorf("Append: unexpected error: %v", err)
+		}
+	}
+}
+
+func TestFieldMaxDepth(t *testing.T) {
+	opt := WithFieldMaxDepth("message_field", 2)
+	for _, tt := range []struct {
+		mask string
+		err  bool
+	}{
+		{mask: "message_field"},
+		{mask: "message_field.message_field.int32_field"},
+		{mask: "repeated_message_field.*.message_field.message_field"},
+		{mask: "message_field.repeated_message_field.*.message_field"},
+		{mask: "map_string_message_field.foo.message_field.map_string_message_field.bar.message_field"},
+		{mask: "message_field.message_field.message_field", err: true},
+		{mask: "message_field.repeated_message_field.0.message_field.message_field", err: true},
+		{mask: "int32_field,message_field.message_field.message_field.int32_field", err: true},
+	} {
+		_, err := Parse[*testpb.Message](tt.mask, opt)
+		if tt.err && err == nil {
+			t.Errorf("Parse(%q): expected error", tt.mask)
+		} else if !tt.err && err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tt.mask, err)
+		}
+	}
+
+	fm, err := Parse[*testpb.Message]("message_field.int32_field", opt)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := fm.Append("message_field.message_field.message_field"); err == nil {
+			t.Errorf("Append: expected error")
+		} else if msg := err.Error(); !strings.Contains(msg, "dev.bursavich.fieldmask.test.Message.message_field") || !strings.Contains(msg, "2") {
+			t.Errorf("Append: error doesn't name the field and limit: %v", err)
+		}
+		if err := fm.Append("message_field.message_field.string_field"); err != nil {
+			t.Errorf("Append: unexpected error: %v", err)
+		}
+	}
+
+	// Masks built from a shared mask have their own depth counts.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := fm.Union(fm); err != nil {
+				t.Errorf("Union: unexpected error: %v", err)
+			}
+			fm.SplitByTopLevel()
+			if err := fm.ValidatePaths([]string{"message_field.message_field.int32_field"}); err != nil {
+				t.Errorf("ValidatePaths: unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, opt := range []Option{
+		WithFieldMaxDepth("unknown_field", 1),
+		WithFieldMaxDepth("message_field", -1),
+	} {
+		if _, err := Parse[*testpb.Message]("int32_field", opt); err == nil {
+			t.Errorf("Parse: expected error for invalid option")
+		}
+	}
+}
+
+func TestGlobExpansion(t *testing.T) {
+	glob := WithGlobExpansion(true)
+	msg := &testpb.Message{
+		Int32Field:  1,
+		Int64Field:  2,
+		StringField: "foo",
+		MessageField: &testpb.Message{
+			Int32Field:  3,
+			Sint32Field: 4,
+			StringField: "bar",
+		},
+	}
+	basicTest{
+		mask:  "int*_field",
+		opts:  []Option{glob},
+		paths: []string{"int32_field", "int32_oneof_field", "int64_field", "int64_oneof_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			Int32Field: 1,
+			Int64Field: 2,
+		},
+	}.run(t)
+	basicTest{
+		mask: "string_field,message_field.*int32_field",
+		opts: []Option{glob},
+		paths: []string{
+			"message_field.int32_field",
+			"message_field.repeated_int32_field",
+			"message_field.repeated_sint32_field",
+			"message_field.repeated_uint32_field",
+			"message_field.sint32_field",
+			"message_field.uint32_field",
+			"string_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			StringField: "foo",
+			MessageField: &testpb.Message{
+				Int32Field:  3,
+				Sint32Field: 4,
+			},
+		},
+	}.run(t)
+	basicTest{
+		mask:  "message_f*.string_field",
+		opts:  []Option{glob},
+		paths: []string{"message_field.string_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MessageField: &testpb.Message{StringField: "bar"},
+		},
+	}.run(t)
+	basicTest{
+		name:  "wildcard",
+		mask:  "message_field.*",
+		opts:  []Option{glob},
+		paths: []string{"message_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MessageField: msg.MessageField,
+		},
+	}.run(t)
+	basicTest{
+		name:  "json",
+		mask:  "int*4Field",
+		opts:  []Option{glob, WithFieldName(JSONFieldName, true)},
+		paths: []string{"int64Field"},
+		msg:   msg,
+		out: &testpb.Message{
+			Int64Field: 2,
+		},
+	}.run(t)
+	basicTest{
+		name: "disabled",
+		mask: "int*_field",
+		err:  true,
+	}.run(t)
+	basicTest{
+		name: "disabled-leading-star",
+		mask: "map_string_string_field.*foo",
+		err:  true,
+	}.run(t)
+	basicTest{
+		name: "no-match",
+		mask: "nothing*",
+		opts: []Option{glob},
+		err:  true,
+	}.run(t)
+	basicTest{
+		name: "invalid",
+		mask: "int[*_field",
+		opts: []Option{glob},
+		err:  true,
+	}.run(t)
+	basicTest{
+		name: "scalar-subpath",
+		mask: "int*_field.foo",
+		opts: []Option{glob},
+		err:  true,
+	}.run(t)
+}
+
+func TestDropDefaultValuedMessages(t *testing.T) {
+	drop := WithDropDefaultValuedMessages(true)
+	msg := &testpb.Message{
+		Int32Field: 1,
+		MessageField: &testpb.Message{
+			StringField:  "foo",
+			MessageField: &testpb.Message{StringField: "bar"},
+		},
+		RepeatedMessageField: []*testpb.Message{
+			{StringField: "a"},
+			{Int32Field: 2},
+			{StringField: "b", Int32Field: 3},
+		},
+		MapStringMessageField: map[string]*testpb.Message{
+			"a": {StringField: "a"},
+			"b": {Int32Field: 4},
+		},
+		OneofField: &testpb.Message_MessageOneofField{
+			MessageOneofField: &testpb.Message{StringField: "c"},
+		},
+	}
+
+	basicTest{
+		name:  "complete",
+		mask:  "*",
+		opts:  []Option{drop},
+		paths: []string{"*"},
+		msg: &testpb.Message{
+			Int32Field:           1,
+			MessageField:         &testpb.Message{MessageField: &testpb.Message{}},
+			RepeatedMessageField: []*testpb.Message{{}, {Int32Field: 2}},
+		},
+		out: &testpb.Message{
+			Int32Field:           1,
+			RepeatedMessageField: []*testpb.Message{{Int32Field: 2}},
+		},
+	}.run(t)
+	basicTest{
+		name: "nested",
+		mask: "int32_field,message_field.message_field.int32_field,repeated_message_field.*.int32_field,map_string_message_field.*.int32_field,message_oneof_field.int32_field",
+		opts: []Option{drop},
+		paths: []string{
+			"int32_field",
+			"map_string_message_field.*.int32_field",
+			"message_field.message_field.int32_field",
+			"message_oneof_field.int32_field",
+			"repeated_message_field.*.int32_field",
+		},
+		msg: msg,
+		out: &testpb.Message{
+			Int32Field: 1,
+			RepeatedMessageField: []*testpb.Message{
+				{Int32Field: 2},
+				{Int32Field: 3},
+			},
+			MapStringMessageField: map[string]*testpb.Message{
+				"b": {Int32Field: 4},
+			},
+			OneofField: &testpb.Message_MessageOneofField{
+				MessageOneofField: &testpb.Message{},
+			},
+		},
+	}.run(t)
+	basicTest{
+		name:  "retained",
+		mask:  "message_field.message_field.int32_field",
+		paths: []string{"message_field.message_field.int32_field"},
+		msg:   msg,
+		out: &testpb.Message{
+			MessageField: &testpb.Message{MessageField: &testpb.Message{}},
+		},
+	}.run(t)
+
+	fm, err := Parse[*testpb.Message]("int32_field,message_field.int32_field", drop)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if !fm.WouldModify(&testpb.Message{Int32Field: 1, MessageField: &testpb.Message{}}) {
+		t.Error("WouldModify: expected modification of empty message")
+	}
+	if fm.WouldModify(&testpb.Message{Int32Field: 1, MessageField: &testpb.Message{Int32Field: 2}}) {
+		t.Error("WouldModify: unexpected modification")
+	}
+}
+
+func TestWrapperPresence(t *testing.T) {
+	presence := []Option{WithWrapperPresence(true)}
+	for _, tt := range []updateTest{
+		{
+			name: "absent",
+			mask: "wrapped_int32_value.value",
+			dst:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(5)},
+			src:  &testpb.Message{},
+			out:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(0)},
+		},
+		{
+			name: "absent/presence",
+			mask: "wrapped_int32_value.value",
+			opts: presence,
+			dst:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(5)},
+			src:  &testpb.Message{},
+			out:  &testpb.Message{},
+		},
+		{
+			name: "default",
+			mask: "wrapped_int32_value.value",
+			dst:  &testpb.Message{},
+			src:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(0)},
+			out:  &testpb.Message{},
+		},
+		{
+			name: "default/presence",
+			mask: "wrapped_int32_value.value,wrapped_string_value.value",
+			opts: presence,
+			dst:  &testpb.Message{WrappedStringValue: wrapperspb.String("a")},
+			src:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(0), WrappedStringValue: wrapperspb.String("")},
+			out:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(0), WrappedStringValue: wrapperspb.String("")},
+		},
+		{
+			name: "value/presence",
+			mask: "wrapped_int32_value.value",
+			opts: presence,
+			dst:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(5)},
+			src:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(7)},
+			out:  &testpb.Message{WrappedInt32Value: wrapperspb.Int32(7)},
+		},
+		{
+			name: "nested/presence",
+			mask: "message_field.wrapped_int32_value.value",
+			opts: presence,
+			dst:  &testpb.Message{MessageField: &testpb.Message{Int32Field: 1, WrappedInt32Value: wrapperspb.Int32(5)}},
+			src:  &testpb.Message{MessageField: &testpb.Message{}},
+			out:  &testpb.Message{MessageField: &testpb.Message{Int32Field: 1}},
+		},
+	} {
+		tt.run(t)
+	}
+}
+
+func TestUpdateReplacesMessages(t *testing.T) {
+	unknown := protowire.AppendTag(nil, 9999, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	nested := &testpb.Message{StringField: "dst", RepeatedInt32Field: []int32{1}}
+	nested.ProtoReflect().SetUnknown(unknown)
+	dst := &testpb.Message{
+		Int32Field:   1,
+		MessageField: &testpb.Message{Int32Field: 2, MessageField: nested},
+	}
+	src := &testpb.Message{
+		Int32Field:   3,
+		MessageField: &testpb.Message{Int32Field: 4, MessageField: &testpb.Message{RepeatedInt32Field: []int32{2}}},
+	}
+	appended := clone(nested)
+	appended.RepeatedInt32Field = []int32{1, 2}
+	merged := clone(appended)
+	merged.StringField = ""
+	replace := WithUpdateReplacesMessages(true)
+	appends := WithUpdateRepeated(UpdateAppendsRepeated)
+	for _, tt := range []updateTest{
+		{
+			name: "merge",
+			mask: "message_field.message_field",
+			opts: []Option{appends},
+			dst:  dst,
+			src:  src,
+			out: &testpb.Message{
+				Int32Field:   1,
+				MessageField: &testpb.Message{Int32Field: 2, MessageField: merged},
+			},
+		},
+		{
+			name: "replace",
+			mask: "message_field.message_field",
+			opts: []Option{appends, replace},
+			dst:  dst,
+			src:  src,
+			out: &testpb.Message{
+				Int32Field:   1,
+				MessageField: &testpb.Message{Int32Field: 2, MessageField: src.MessageField.MessageField},
+			},
+		},
+		{
+			name: "partial",
+			mask: "message_field.message_field.repeated_int32_field",
+			opts: []Option{appends, replace},
+			dst:  dst,
+			src:  src,
+			out: &testpb.Message{
+				Int32Field:   1,
+				MessageField: &testpb.Message{Int32Field: 2, MessageField: appended},
+			},
+		},
+		{
+			name: "absent",
+			mask: "message_field.message_field",
+			opts: []Option{replace},
+			dst:  dst,
+			src:  &testpb.Message{MessageField: &testpb.Message{}},
+			out: &testpb.Message{
+				Int32Field:   1,
+				MessageField: &testpb.Message{Int32Field: 2},
+			},
+		},
+	} {
+		tt.run(t)
+	}
+}
+
+func TestRequireMaskedFieldsPresent(t *testing.T) {
+	require := WithRequireMaskedFieldsPresent(true)
+	dst := &testpb.Message{
+		Int32Field:   1,
+		StringField:  "foo",
+		MessageField: &testpb.Message{Int32Field: 2, StringField: "bar"},
+	}
+	updateTest{
+		name: "present",
+		mask: "int32_field,message_field.string_field,map_string_string_field",
+		opts: []Option{require},
+		dst:  dst,
+		src: &testpb.Message{
+			Int32Field:           3,
+			MessageField:         &testpb.Message{StringField: "baz"},
+			MapStringStringField: map[string]string{"a": "b"},
+		},
+		out: &testpb.Message{
+			Int32Field:           3,
+			StringField:          "foo",
+			MessageField:         &testpb.Message{Int32Field: 2, StringField: "baz"},
+			MapStringStringField: map[string]string{"a": "b"},
+		},
+	}.run(t)
+	updateTest{
+		name: "complete",
+		mask: "message_field",
+		opts: []Option{require},
+		dst:  dst,
+		src:  &testpb.Message{MessageField: &testpb.Message{}},
+		out:  &testpb.Message{Int32Field: 1, StringField: "foo", MessageField: &testpb.Message{}},
+	}.run(t)
+
+	fm, err := Parse[*testpb.Message](
+		"int32_field,string_field,message_field.int32_field,message_field.string_field,repeated_int32_field",
+		require,
+		WithAlias(map[string]string{"msg": "message_field"}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	got := clone(dst)
+	err = fm.Update(got, &testpb.Message{StringField: "baz", MessageField: &testpb.Message{Int32Field: 4}})
+	if want := "masked fields absent in source: int32_field, msg.string_field, repeated_int32_field"; err == nil || err.Error() != want {
+		t.Errorf("Update: unexpected error: got: %v; want: %v", err, want)
+	}
+	if err := fm.UpdateReflect(got.ProtoReflect(), nil); err == nil {
+		t.Error("UpdateReflect: expected error for nil source")
+	}
+	if diff := protoDiff(dst, got); diff != "" {
+		t.Errorf("Update: modified destination:\n%s", diff)
+	}
+}
+
+func TestUpdatePruneEmptyMessages(t *testing.T) {
+	var (
+		absent  = &testpb.Message{}
+		empty   = &testpb.Message{MessageField: &testpb.Message{}}
+		leaf    = &testpb.Message{MessageField: &testpb.Message{Int32Field: 1}}
+		other   = &testpb.Message{MessageField: &testpb.Message{StringField: "other"}}
+		updated = &testpb.Message{MessageField: &testpb.Message{Int32Field: 2}}
+	)
+	for _, tt := range []struct {
+		name  string
+		dst   *testpb.Message
+		src   *testpb.Message
+		out   *testpb.Message
+		prune *testpb.Message
+	}{
+		{name: "dst-absent:src-absent", dst: absent, src: absent, out: absent, prune: absent},
+		{name: "dst-absent:src-empty", dst: absent, src: empty, out: absent, prune: absent},
+		{name: "dst-absent:src-leaf", dst: absent, src: updated, out: updated, prune: updated},
+		{name: "dst-empty:src-absent", dst: empty, src: absent, out: empty, prune: absent},
+		{name: "dst-empty:src-empty", dst: empty, src: empty, out: empty, prune: empty},
+		{name: "dst-empty:src-leaf", dst: empty, src: updated, out: updated, prune: updated},
+		{name: "dst-leaf:src-absent", dst: leaf, src: absent, out: empty, prune: absent},
+		{name: "dst-leaf:src-empty", dst: leaf, src: empty, out: empty, prune: empty},
+		{name: "dst-leaf:src-leaf", dst: leaf, src: updated, out: updated, prune: updated},
+		{name: "dst-other:src-absent", dst: other, src: absent, out: other, prune: other},
+	} {
+		updateTest{
+			name: tt.name,
+			mask: "message_field.int32_field",
+			dst:  tt.dst,
+			src:  tt.src,
+			out:  tt.out,
+		}.run(t)
+
+		updateTest{
+			name: tt.name + ":prune",
+			mask: "message_field.int32_field",
+			opts: []Option{WithUpdatePruneEmptyMessages(true)},
+			dst:  tt.dst,
+			src:  tt.src,
+			out:  tt.prune,
+		}.run(t)
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	msg := &testpb.Proto2Message{
+		Int32Field:  proto.Int32(1),
+		StringField: proto.String("a"),
+	}
+	proto.SetExtension(msg, testpb.E_Int32Ext, int32(2))
+	proto.SetExtension(msg, testpb.E_MessageExt, &testpb.Proto2Message{
+		Int32Field:  proto.Int32(3),
+		StringField: proto.String("b"),
+	})
+	proto.SetExtension(msg, testpb.E_RepeatedStringExt, []string{"c", "d"})
+
+	resolver := new(protoregistry.Types)
+	for _, xt := range []protoreflect.ExtensionType{testpb.E_Int32Ext, testpb.E_MessageExt, testpb.E_RepeatedStringExt} {
+		if err := resolver.RegisterExtension(xt); err != nil {
+			t.Fatalf("Failed to register extension: %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		name    string
+		paths   []string
+		options []Option
+		want    func() *testpb.Proto2Message
+	}{
+		{
+			name:    "complete-allowed",
+			paths:   []string{"*"},
+			options: []Option{WithExtensions(true)},
+			want:    func() *testpb.Proto2Message { return clone(msg) },
+		},
+		{
+			name:    "named",
+			paths:   []string{"int32_field", "`dev.bursavich.fieldmask.test.int32_ext`", "`dev.bursavich.fieldmask.test.message_ext`.string_field"},
+			options: []Option{WithExtensions(true)},
+			want: func() *testpb.Proto2Message {
+				out := &testpb.Proto2Message{Int32Field: proto.Int32(1)}
+				proto.SetExtension(out, testpb.E_Int32Ext, int32(2))
+				proto.SetExtension(out, testpb.E_MessageExt, &testpb.Proto2Message{StringField: proto.String("b")})
+				return out
+			},
+		},
+		{
+			name:    "resolver",
+			paths:   []string{"`dev.bursavich.fieldmask.test.repeated_string_ext`"},
+			options: []Option{WithExtensions(true), WithExtensionResolver(resolver)},
+			want: func() *testpb.Proto2Message {
+				out := &testpb.Proto2Message{}
+				proto.SetExtension(out, testpb.E_RepeatedStringExt, []string{"c", "d"})
+				return out
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := New[*testpb.Proto2Message](tt.paths, tt.options...)
+			if err != nil {
+				t.Fatalf("Failed to create mask: %v", err)
+			}
+			want := tt.want()
+			masked := clone(msg)
+			fm.Mask(masked)
+			cloned := fm.Clone(msg)
+			updated := &testpb.Proto2Message{}
+			if err := fm.Update(updated, msg); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			for name, out := range map[string]*testpb.Proto2Message{
+				"Mask":   masked,
+				"Clone":  cloned,
+				"Update": updated,
+			} {
+				if diff := protoDiff(want, out); diff != "" {
+					t.Errorf("%s: unexpected diff:\n%s", name, diff)
+				}
+			}
+		})
+	}
+
+	t.Run("update-clears", func(t *testing.T) {
+		fm, err := New[*testpb.Proto2Message]([]string{"*"}, WithExtensions(true))
+		if err != nil {
+			t.Fatalf("Failed to create mask: %v", err)
+		}
+		dst := clone(msg)
+		src := &testpb.Proto2Message{Int32Field: proto.Int32(4)}
+		if err := fm.Update(dst, src); err != nil {
+			t.Fatalf("Update: unexpected error: %v", err)
+		}
+		if diff := protoDiff(src, dst); diff != "" {
+			t.Errorf("Update: unexpected diff:\n%s", diff)
+		}
+	})
+
+	for _, tt := range []struct {
+		name    string
+		path    string
+		options []Option
+	}{
+		{
+			name: "disallowed",
+			path: "`dev.bursavich.fieldmask.test.int32_ext`",
+		},
+		{
+			name:    "unknown",
+			path:    "`dev.bursavich.fieldmask.test.unknown_ext`",
+			options: []Option{WithExtensions(true)},
+		},
+		{
+			name:    "unresolved",
+			path:    "`dev.bursavich.fieldmask.test.int32_ext`",
+			options: []Option{WithExtensions(true), WithExtensionResolver(new(protoregistry.Types))},
+		},
+	} {
+		t.Run("error-"+tt.name, func(t *testing.T) {
+			if _, err := New[*testpb.Proto2Message]([]string{tt.path}, tt.options...); err == nil {
+				t.Fatalf("Expected error for path: %s", tt.path)
+			}
+		})
+	}
+}
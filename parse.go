@@ -13,11 +13,18 @@ import (
 	"unicode/utf8"
 
 	"bursavich.dev/fieldmask/internal/quote"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var errSyntax = strconv.ErrSyntax
 
-func nextPath(s string) (path, rest string, err error) {
+// defaultPathSep is the default separator between the segments of a path.
+const defaultPathSep = '.'
+
+// nextPath returns the first path in the comma-separated list and the rest of the list.
+// A path may end with a separator, which denotes an explicitly empty subpath. If glob
+// is true, a segment may be a glob that begins with "*".
+func nextPath(s string, sep rune, glob bool) (path, rest string, err error) {
 	if s == "" {
 		return "", "", errSyntax
 	}
@@ -25,76 +32,179 @@ func nextPath(s string) (path, rest string, err error) {
 	for {
 		var tok string
 
-		tok, rest, err = nextToken(rest)
-		if err != nil || tok == "." || tok == "," {
+		tok, rest, err = scanToken(rest, sep, glob)
+		if err != nil || isSep(tok, sep) || tok == "," {
 			return "", "", errSyntax
 		}
 		if rest == "" {
 			return s, "", nil
 		}
 
-		tok, rest, err = nextToken(rest)
-		if err != nil || rest == "" {
+		tok, rest, err = nextToken(rest, sep)
+		if err != nil {
+			return "", "", errSyntax
+		}
+		if isSep(tok, sep) {
+			switch {
+			case rest == "":
+				return s, "", nil
+			case rest[0] == ',':
+				tok, rest = rest[:1], rest[1:]
+			}
+		}
+		if rest == "" {
 			return "", "", errSyntax
 		}
 		if tok == "," {
 			return s[:len(s)-len(rest)-1], rest, nil
 		}
-		if tok != "." {
+		if !isSep(tok, sep) {
 			return "", "", errSyntax
 		}
 	}
 }
 
-func nextSegment(s string) (segment, rest string, err error) {
-	segment, rest, err = nextToken(s)
-	if err != nil || segment == "." || segment == "," {
+// spacesToCommas returns the list of paths with each run of whitespace that separates two paths
+// replaced by a comma. Whitespace that's adjacent to a comma or at either end is removed.
+func spacesToCommas(s string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(s))
+	pending := false
+	for len(s) > 0 {
+		r, n := utf8.DecodeRuneInString(s)
+		switch {
+		case unicode.IsSpace(r):
+			pending = true
+			s = s[n:]
+			continue
+		case r == ',':
+			pending = false
+		case pending && b.Len() > 0 && !strings.HasSuffix(b.String(), ","):
+			b.WriteByte(',')
+		}
+		pending = false
+		if r == '`' {
+			quoted, err := quote.QuotedPrefix(s, '`')
+			if err != nil {
+				return "", errSyntax
+			}
+			n = len(quoted)
+		}
+		b.WriteString(s[:n])
+		s = s[n:]
+	}
+	return b.String(), nil
+}
+
+func nextSegment(s string, sep rune) (segment, rest string, err error) {
+	return splitSegment(s, sep, false)
+}
+
+// nextGlobSegment is like nextSegment, but the segment may be a glob that begins with "*".
+func nextGlobSegment(s string, sep rune) (segment, rest string, err error) {
+	return splitSegment(s, sep, true)
+}
+
+func splitSegment(s string, sep rune, glob bool) (segment, rest string, err error) {
+	segment, rest, err = scanToken(s, sep, glob)
+	if err != nil || isSep(segment, sep) || segment == "," {
 		return "", "", errSyntax
 	}
 	if rest == "" {
 		return segment, "", nil
 	}
-	next, rest, err := nextToken(rest)
-	if err != nil || next != "." || rest == "" {
+	next, rest, err := nextToken(rest, sep)
+	if err != nil || !isSep(next, sep) || rest == "" {
 		return "", "", errSyntax
 	}
 	return segment, rest, nil
 }
 
-func nextToken(s string) (token, rest string, err error) {
+func nextToken(s string, sep rune) (token, rest string, err error) {
+	return scanToken(s, sep, false)
+}
+
+// scanToken returns the first token and the rest of the string. If glob is true,
+// a name that begins with "*" is a single token, rather than a wildcard followed by a name.
+func scanToken(s string, sep rune, glob bool) (token, rest string, err error) {
 	if s == "" {
 		return "", "", errSyntax
 	}
+	if r, n := utf8.DecodeRuneInString(s); r == sep {
+		return s[:n], s[n:], nil
+	}
 	switch s[0] {
-	case '.', ',', '*':
+	case ',':
 		return s[0:1], s[1:], nil
+	case '*':
+		if r, _ := utf8.DecodeRuneInString(s[1:]); !glob || len(s) == 1 || r == sep || r == ',' {
+			return s[0:1], s[1:], nil
+		}
+		if i := strings.IndexFunc(s, func(r rune) bool { return r == sep || r == ',' }); i != -1 {
+			return s[:i], s[i:], nil
+		}
+		return s, "", nil
 	case '`':
-		quoted, err := strconv.QuotedPrefix(s)
+		quoted, err := quote.QuotedPrefix(s, '`')
 		if err != nil {
 			return "", "", errSyntax
 		}
 		return quoted, s[len(quoted):], nil
+	case '(':
+		// A parenthesized name of a google.protobuf.Any's packed type may contain the separator.
+		if i := strings.IndexByte(s, ')'); i != -1 {
+			return s[:i+1], s[i+1:], nil
+		}
+		return "", "", errSyntax
 	default:
-		if i := strings.IndexAny(s, ".,"); i != -1 {
+		if i := strings.IndexFunc(s, func(r rune) bool { return r == sep || r == ',' }); i != -1 {
 			return s[:i], s[i:], nil
 		}
 		return s, "", nil
 	}
 }
 
-func joinPath(a, b string) string {
-	return a + "." + b
+// parseFieldNumber returns the field number named by a segment of the form "#N"
+// and a value indicating if the segment has that form.
+func parseFieldNumber(segment string) (protoreflect.FieldNumber, bool) {
+	if len(segment) < 2 || segment[0] != '#' || segment[1] < '0' || segment[1] > '9' {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(segment[1:], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return protoreflect.FieldNumber(n), true
+}
+
+func isSep(token string, sep rune) bool {
+	r, n := utf8.DecodeRuneInString(token)
+	return r == sep && n == len(token)
+}
+
+// hasPathPrefix returns a value indicating if the path is equal to
+// the prefix or begins with the prefix followed by a separator.
+func hasPathPrefix(path, prefix string, sep rune) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	rest := path[len(prefix):]
+	return rest == "" || strings.HasPrefix(rest, string(sep))
+}
+
+func joinPath(a, b string, sep rune) string {
+	return a + string(sep) + b
 }
 
-func maybeQuote(segment string) string {
-	if shouldQuote(segment) {
+func maybeQuote(segment string, sep rune) string {
+	if shouldQuote(segment, sep) {
 		return quote.With(segment, '`')
 	}
 	return segment
 }
 
-func shouldQuote(s string) bool {
-	if s == "" || s == "*" {
+func shouldQuote(s string, sep rune) bool {
+	if s == "" || s[0] == '*' || s[0] == '(' {
 		return true
 	}
 	for width := 0; len(s) > 0; s = s[width:] {
@@ -106,7 +216,7 @@ func shouldQuote(s string) bool {
 				return true
 			}
 		}
-		if r == '.' || r == ',' || r == '`' {
+		if r == sep || r == ',' || r == '`' {
 			return true
 		}
 		if unicode.IsControl(r) || !strconv.IsPrint(r) {
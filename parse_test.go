@@ -7,7 +7,11 @@
 package fieldmask
 
 import (
+	"math/rand"
 	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestNextPath(t *testing.T) {
@@ -45,6 +49,22 @@ func TestNextPath(t *testing.T) {
 		{
 			name: "trailing-dot",
 			in:   "foo.",
+			path: "foo.",
+		},
+		{
+			name: "trailing-dot-multipath",
+			in:   "foo.*.,bar",
+			path: "foo.*.",
+			rest: "bar",
+		},
+		{
+			name: "trailing-dot-comma",
+			in:   "foo.,",
+			err:  errSyntax,
+		},
+		{
+			name: "double-dot",
+			in:   "foo..bar",
 			err:  errSyntax,
 		},
 		{
@@ -92,7 +112,7 @@ func TestNextPath(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			path, rest, err := nextPath(tt.in)
+			path, rest, err := nextPath(tt.in, defaultPathSep, false)
 			if err != tt.err {
 				t.Errorf("unexpected err: got: %v; want: %v", err, tt.err)
 			}
@@ -182,7 +202,7 @@ func TestNextSegment(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			part, rest, err := nextSegment(tt.in)
+			part, rest, err := nextSegment(tt.in, defaultPathSep)
 			if err != tt.err {
 				t.Errorf("unexpected err: got: %v; want: %v", err, tt.err)
 			}
@@ -195,3 +215,85 @@ func TestNextSegment(t *testing.T) {
 		})
 	}
 }
+
+func TestQuotedKeyRoundTrip(t *testing.T) {
+	keys := []string{"", "*", ".", ",", "`", "\\", " ", "  ", "\t", "\x00", "\xff", "`*`", "a.b", "a,b", "*.*"}
+	const alphabet = "ab*.,` \\\t\n\x00\xffé"
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		key := make([]byte, rng.Intn(8))
+		for j := range key {
+			key[j] = alphabet[rng.Intn(len(alphabet))]
+		}
+		keys = append(keys, string(key))
+	}
+	for _, key := range keys {
+		path := "map_string_message_field." + maybeQuote(key, defaultPathSep) + ".int32_field"
+		fm, err := Parse[*testpb.Message](path)
+		if err != nil {
+			t.Fatalf("Parse: unexpected error for key %q: %v", key, err)
+		}
+		if diff := cmp.Diff([]string{path}, fm.Paths()); diff != "" {
+			t.Fatalf("Paths: unexpected diff for key %q:\n%s", key, diff)
+		}
+		msg := &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				key:     simpleMsg(1, "key"),
+				"other": simpleMsg(2, "other"),
+			},
+		}
+		want := &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				key: {Int32Field: 1},
+			},
+		}
+		if key == "other" {
+			want.MapStringMessageField["other"] = &testpb.Message{Int32Field: 2}
+		}
+		fm.Mask(msg)
+		if diff := protoDiff(want, msg); diff != "" {
+			t.Fatalf("Mask: unexpected diff for key %q:\n%s", key, diff)
+		}
+	}
+}
+
+func TestSpaceSeparatedPaths(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  bool
+	}{
+		{in: "int32_field string_field", want: "int32_field,string_field"},
+		{in: "  int32_field \t\n string_field  ", want: "int32_field,string_field"},
+		{in: "int32_field , string_field", want: "int32_field,string_field"},
+		{in: "int32_field,string_field message_field.int32_field", want: "int32_field,message_field.int32_field,string_field"},
+		{in: "map_string_string_field.`a b` int32_field", want: "int32_field,map_string_string_field.`a b`"},
+		{in: "int32_field,,string_field", err: true},
+		{in: "   ", err: true},
+		{in: "map_string_string_field.`a b", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.in, WithSpaceSeparatedPaths(true))
+			if tt.err {
+				if err == nil {
+					t.Fatalf("Parse: expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			if got := fm.String(); got != tt.want {
+				t.Fatalf("String: got: %q; want: %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Parse[*testpb.Message]("int32_field string_field"); err == nil {
+		t.Error("Parse: expected error for space-separated paths by default")
+	}
+	if _, err := Parse[*testpb.Message]("int32_field", WithSpaceSeparatedPaths(true), WithPathSeparator(' ')); err == nil {
+		t.Error("Parse: expected error for whitespace path separator")
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RangeMasked calls fn for each populated field of the message that's retained by the mask,
+// with the value that Clone would set, until fn returns false. The values may be shared with
+// the message, so neither may be modified.
+func (fm *FieldMask[T]) RangeMasked(msg T, fn func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool) {
+	// Dropping default-valued messages and masking packed messages depend on the whole
+	// masked message, so it's cloned and ranged.
+	if fm.dropEmptyMessages || len(fm.msg.anyMasks) > 0 {
+		fm.settings.rangeFields(fm.Clone(msg).ProtoReflect(), fn)
+		return
+	}
+	fm.msg.rangeMasked(msg.ProtoReflect(), fn)
+}
+
+// rangeMasked calls fn for each populated field of the message that's retained by the mask,
+// with the value that cloneInto would set, until fn returns false.
+func (mm *msgMask) rangeMasked(msg protoreflect.Message, fn func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	s := mm.settings
+	s.rangeFields(msg, func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if !s.allow(fd) || !s.allowValue(fd, val) {
+			return true
+		}
+		var f fieldMask
+		if !mm.complete() {
+			if f = mm.fields[s.fieldKey(fd)]; f == nil {
+				return true
+			}
+		}
+		switch {
+		case (f == nil || f.complete()) && s.sharesValue(fd):
+			// The value is covered as a whole and cloning it wouldn't change it,
+			// so it's shared as is, including the underlying array of bytes.
+		case f != nil:
+			// The value is a masked clone of just the field's value.
+			val = f.clone(msg, val, nil)
+		default:
+			val = s.cloneValue(msg, fd, val)
+		}
+		if s.omitEmpty(fd, val) {
+			return true
+		}
+		return fn(fd, val)
+	})
+}
+
+// sharesValue returns a value indicating if cloning a value of the field as a whole
+// would yield an equal value, so that it may be shared rather than copied.
+func (s *settings) sharesValue(fd protoreflect.FieldDescriptor) bool {
+	switch {
+	case s.cloneTransform != nil:
+		return false
+	case !fd.IsList() && !fd.IsMap() && fd.Message() == nil:
+		return true
+	case s.filtering(), s.cloneDedupRepeated, s.omitEmptyContainers, s.dropEmptyMapValues:
+		return false
+	case fd.Message() == nil && (!fd.IsMap() || fd.MapValue().Message() == nil):
+		return true // A list or map of scalars.
+	default:
+		// Cloned messages lose their unknown fields and extensions unless they're retained.
+		return s.maskUnknowns == MaskRetainsUnknowns && s.extensions
+	}
+}
+
+// cloneValue returns a copy of the value of the message's field, which is covered as a whole.
+func (s *settings) cloneValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, val protoreflect.Value) protoreflect.Value {
+	switch {
+	case fd.IsList():
+		out := msg.NewField(fd)
+		s.copyList(out.List(), val.List(), fd, nil)
+		return out
+	case fd.IsMap():
+		out := msg.NewField(fd)
+		s.copyMap(out.Map(), val.Map(), fd, nil)
+		return out
+	case fd.Message() != nil:
+		out := msg.NewField(fd)
+		s.copyMessage(out.Message(), val.Message(), nil)
+		return out
+	default:
+		return s.cloneScalar(fd, val, nil)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestRangeMasked(t *testing.T) {
+	for _, tt := range []struct {
+		mask string
+		opts []Option
+	}{
+		{mask: "*"},
+		{mask: "*", opts: []Option{WithMaskUnknowns(MaskRetainsUnknowns), WithExtensions(true)}},
+		{mask: "int32_field,bytes_field,repeated_string_field,message_field"},
+		{mask: "message_field.string_field,map_string_message_field.foo.int32_field,repeated_message_field.*.bool_field"},
+		{mask: "map_string_string_field,repeated_int32_field", opts: []Option{WithOmitEmptyContainers(true)}},
+		{mask: "message_field", opts: []Option{WithDropDefaultValuedMessages(true)}},
+		{mask: "string_field,repeated_string_field", opts: []Option{WithCloneTransform(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfString("x")
+		})}},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+		if err != nil {
+			t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+		}
+		msg := clone(testMsg)
+		got := &testpb.Message{}
+		fm.RangeMasked(msg, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			got.ProtoReflect().Set(fd, v)
+			return true
+		})
+		if diff := protoDiff(fm.Clone(testMsg), got); diff != "" {
+			t.Errorf("RangeMasked(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+		if diff := protoDiff(testMsg, msg); diff != "" {
+			t.Errorf("RangeMasked(%q): modified message:\n%s", tt.mask, diff)
+		}
+	}
+}
+
+func TestRangeMaskedShares(t *testing.T) {
+	fm, err := Parse[*testpb.Message]("bytes_field,repeated_string_field,message_field.int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	msg := &testpb.Message{
+		BytesField:          []byte("foo"),
+		RepeatedStringField: []string{"a", "b"},
+		MessageField:        &testpb.Message{Int32Field: 1, StringField: "bar"},
+	}
+	n := 0
+	fm.RangeMasked(msg, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		n++
+		switch fd.Name() {
+		case "bytes_field":
+			if &v.Bytes()[0] != &msg.BytesField[0] {
+				t.Error("RangeMasked: bytes were copied")
+			}
+		case "repeated_string_field":
+			msg.RepeatedStringField = append(msg.RepeatedStringField, "c") // A shared list sees the change.
+			if v.List().Len() != 3 {
+				t.Error("RangeMasked: list was copied")
+			}
+		case "message_field":
+			if v.Message().Interface() == msg.MessageField {
+				t.Error("RangeMasked: partially masked message wasn't cloned")
+			}
+			if got := v.Message().Interface().(*testpb.Message); got.StringField != "" || got.Int32Field != 1 {
+				t.Errorf("RangeMasked: unexpected message: %v", got)
+			}
+		}
+		return true
+	})
+	if n != 3 {
+		t.Errorf("RangeMasked: got %d fields; want 3", n)
+	}
+
+	n = 0
+	fm.RangeMasked(msg, func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("RangeMasked: got %d fields after stopping; want 1", n)
+	}
+}
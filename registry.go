@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A MaskRegistry holds field masks for many message types and masks each message with the mask
+// registered for its type, so that one component may mask a heterogeneous batch of messages.
+// Masks are registered with RegisterMask. A registry must not be modified concurrently with
+// calls to Mask.
+type MaskRegistry struct {
+	masks            map[protoreflect.FullName]reflectMasker
+	passUnregistered bool
+}
+
+// A RegistryOption is an option for a MaskRegistry.
+type RegistryOption interface{ applyRegistryOption(*MaskRegistry) }
+
+type registryOptionFunc func(*MaskRegistry)
+
+func (fn registryOptionFunc) applyRegistryOption(r *MaskRegistry) { fn(r) }
+
+// WithPassUnregistered returns a registry option that sets whether a message of a type without
+// a registered mask is left unchanged by Mask, rather than resulting in an error. By default,
+// unregistered types result in an error.
+func WithPassUnregistered(pass bool) RegistryOption {
+	return registryOptionFunc(func(r *MaskRegistry) { r.passUnregistered = pass })
+}
+
+// NewMaskRegistry returns an empty registry with the given options.
+func NewMaskRegistry(options ...RegistryOption) *MaskRegistry {
+	r := &MaskRegistry{masks: make(map[protoreflect.FullName]reflectMasker)}
+	for _, o := range options {
+		o.applyRegistryOption(r)
+	}
+	return r
+}
+
+// RegisterMask registers the mask for messages of its type, which is named by its message descriptor,
+// replacing any mask already registered for the type.
+func RegisterMask[T proto.Message](r *MaskRegistry, fm *FieldMask[T]) {
+	r.masks[fm.rootDesc.FullName()] = fm
+}
+
+// Mask masks the message in place with the mask registered for its type.
+// It returns an error if no mask is registered for its type, unless unregistered
+// types are passed through.
+func (r *MaskRegistry) Mask(m proto.Message) error {
+	msg := m.ProtoReflect()
+	name := msg.Descriptor().FullName()
+	fm, ok := r.masks[name]
+	if !ok {
+		if r.passUnregistered {
+			return nil
+		}
+		return fmt.Errorf("fieldmask: no mask registered for message type: %v", name)
+	}
+	fm.maskReflect(msg)
+	return nil
+}
+
+// A reflectMasker masks messages without regard to their Go types.
+type reflectMasker interface {
+	maskReflect(msg protoreflect.Message)
+}
+
+var _ reflectMasker = (*FieldMask[proto.Message])(nil)
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMaskRegistry(t *testing.T) {
+	msgMask, err := Parse[*testpb.Message]("int32_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	p2Mask, err := Parse[*testpb.Proto2Message]("string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	r := NewMaskRegistry()
+	RegisterMask(r, msgMask)
+	RegisterMask(r, p2Mask)
+
+	msg := &testpb.Message{Int32Field: 1, StringField: "foo"}
+	p2 := &testpb.Proto2Message{Int32Field: proto.Int32(2), StringField: proto.String("bar")}
+	for _, tt := range []struct {
+		msg  proto.Message
+		want proto.Message
+	}{
+		{msg: msg, want: &testpb.Message{Int32Field: 1}},
+		{msg: p2, want: &testpb.Proto2Message{StringField: proto.String("bar")}},
+	} {
+		if err := r.Mask(tt.msg); err != nil {
+			t.Fatalf("Mask: unexpected error: %v", err)
+		}
+		if diff := protoDiff(tt.want, tt.msg); diff != "" {
+			t.Errorf("Mask: unexpected diff:\n%s", diff)
+		}
+	}
+
+	wrapped := wrapperspb.String("baz")
+	if err := r.Mask(wrapped); err == nil {
+		t.Error("Mask: expected error for unregistered type")
+	}
+	r = NewMaskRegistry(WithPassUnregistered(true))
+	if err := r.Mask(wrapped); err != nil {
+		t.Errorf("Mask: unexpected error for unregistered type: %v", err)
+	}
+	if wrapped.GetValue() != "baz" {
+		t.Errorf("Mask: unregistered message was modified: %v", wrapped)
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// An UpdateReport describes the map keys and lists of the destination message
+// that were removed or rewritten by an update.
+type UpdateReport struct {
+	// DeletedKeys holds the sorted keys deleted from each map field, keyed by the path of the field.
+	DeletedKeys map[string][]string
+	// ReplacedLists holds the paths of the list fields whose existing elements were changed or removed.
+	ReplacedLists []string
+	// AppendedLists holds the paths of the list fields to which elements were only appended.
+	AppendedLists []string
+}
+
+// UpdateReport updates the destination message, like Update, and returns a report of the map keys
+// it deleted and the lists it changed. It also returns a mask of the fields that the update changed,
+// or nil if it changed nothing, with which the update can be reapplied to the original message.
+func (fm *FieldMask[T]) UpdateReport(dst, src T) (*UpdateReport, *FieldMask[T], error) {
+	if any(dst) == nil || !dst.ProtoReflect().IsValid() {
+		return nil, nil, errNilDestination
+	}
+	before := proto.Clone(dst).ProtoReflect()
+	if err := fm.update(dst, src, nil); err != nil {
+		return nil, nil, err
+	}
+	r := &UpdateReport{}
+	fm.reportMessage(r, "", before, dst.ProtoReflect())
+	return r, fm.changedMask(before, dst.ProtoReflect()), nil
+}
+
+// changedMask returns a mask covering the fields that differ between the messages, or nil if
+// they're equal. The mask has the default update behavior, so that updating the first message
+// with the second message reproduces the second message.
+func (fm *FieldMask[T]) changedMask(before, after protoreflect.Message) *FieldMask[T] {
+	s := fm.settings
+	s.diffPresence = DiffComparesPresence
+	paths := s.diffPaths(before, after)
+	if len(paths) == 0 {
+		return nil
+	}
+	base := &FieldMask[T]{settings: fm.settings.withParseState()}
+	base.resetUpdates()
+	base.alwaysInclude = nil // It covers only the changes.
+	// The paths come from the messages, so they aren't subject to the limits of parsed paths.
+	return base.subMask(paths)
+}
+
+// reportMessage adds the changes between the messages, which must be of the same type, to the report.
+func (s *settings) reportMessage(r *UpdateReport, path string, before, after protoreflect.Message) {
+	fds := before.Descriptor().Fields()
+	for i, n := 0, fds.Len(); i < n; i++ {
+		fd := fds.Get(i)
+		if !before.Has(fd) {
+			continue // Nothing to delete or replace
+		}
+		name := s.fieldKey(fd)
+		if path != "" {
+			name = joinPath(path, name, s.pathSep)
+		}
+		// A field that was cleared as a whole is compared with an empty value,
+		// so each of its keys is deleted or its list is replaced.
+		switch {
+		case fd.IsMap():
+			s.reportMap(r, name, fd, before.Get(fd).Map(), after.Get(fd).Map())
+		case fd.IsList():
+			s.reportList(r, name, before.Get(fd).List(), after.Get(fd).List())
+		case fd.Message() != nil && after.Has(fd):
+			s.reportMessage(r, name, before.Get(fd).Message(), after.Get(fd).Message())
+		}
+	}
+}
+
+func (s *settings) reportMap(r *UpdateReport, name string, fd protoreflect.FieldDescriptor, before, after protoreflect.Map) {
+	isMsg := isMessage(fd.MapValue().Kind())
+	var deleted []string
+	before.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		k := s.quoteKey(s.formatMapKey(fd, key))
+		switch {
+		case !after.Has(key):
+			deleted = append(deleted, k)
+		case isMsg:
+			// Changes within the message values are reported at their own paths.
+			s.reportMessage(r, joinPath(name, k, s.pathSep), val.Message(), after.Get(key).Message())
+		}
+		return true
+	})
+	if len(deleted) == 0 {
+		return
+	}
+	slices.Sort(deleted)
+	if r.DeletedKeys == nil {
+		r.DeletedKeys = make(map[string][]string)
+	}
+	r.DeletedKeys[name] = deleted
+}
+
+func (s *settings) reportList(r *UpdateReport, name string, before, after protoreflect.List) {
+	// A list is replaced if any of its existing elements was changed or removed, even if only in part.
+	n := before.Len()
+	if after.Len() < n {
+		r.ReplacedLists = append(r.ReplacedLists, name)
+		return
+	}
+	for i := 0; i < n; i++ {
+		if !before.Get(i).Equal(after.Get(i)) {
+			r.ReplacedLists = append(r.ReplacedLists, name)
+			return
+		}
+	}
+	if after.Len() > n {
+		r.AppendedLists = append(r.AppendedLists, name)
+	}
+}
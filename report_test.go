@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestUpdateReport(t *testing.T) {
+	newDst := func() *testpb.Message {
+		return &testpb.Message{
+			MapStringStringField: map[string]string{"a": "1", "b": "2", "c": "3"},
+			MessageField: &testpb.Message{
+				MapStringMessageField: map[string]*testpb.Message{
+					"x": {MapInt32StringField: map[int32]string{1: "one", 2: "two"}},
+					"y": {},
+				},
+			},
+			RepeatedInt32Field:   []int32{1, 2},
+			RepeatedStringField:  []string{"a"},
+			RepeatedMessageField: []*testpb.Message{{Int32Field: 1}, {Int32Field: 2}},
+		}
+	}
+	src := &testpb.Message{
+		MapStringStringField: map[string]string{"a": "0"},
+		MessageField: &testpb.Message{
+			MapStringMessageField: map[string]*testpb.Message{
+				"x": {MapInt32StringField: map[int32]string{2: "two"}},
+			},
+		},
+		RepeatedInt32Field:   []int32{3},
+		RepeatedStringField:  []string{"b"},
+		RepeatedMessageField: []*testpb.Message{{Int32Field: 3}},
+	}
+	tests := []struct {
+		name    string
+		mask    string
+		opts    []Option
+		want    *UpdateReport
+		changed []string
+	}{
+		{
+			name: "replace",
+			mask: "map_string_string_field.b,message_field.map_string_message_field.*.map_int32_string_field,repeated_int32_field,repeated_message_field.1",
+			want: &UpdateReport{
+				DeletedKeys: map[string][]string{
+					"map_string_string_field":                                         {"b"},
+					"message_field.map_string_message_field":                          {"y"},
+					"message_field.map_string_message_field.x.map_int32_string_field": {"1"},
+				},
+				ReplacedLists: []string{"repeated_int32_field", "repeated_message_field"},
+			},
+			changed: []string{
+				"map_string_string_field.b",
+				"message_field.map_string_message_field.x.map_int32_string_field.1",
+				"message_field.map_string_message_field.y",
+				"repeated_int32_field",
+				"repeated_message_field",
+			},
+		},
+		{
+			name: "complete",
+			mask: "map_string_string_field,message_field",
+			want: &UpdateReport{
+				DeletedKeys: map[string][]string{
+					"map_string_string_field":                                         {"b", "c"},
+					"message_field.map_string_message_field":                          {"y"},
+					"message_field.map_string_message_field.x.map_int32_string_field": {"1"},
+				},
+			},
+			changed: []string{
+				"map_string_string_field.a",
+				"map_string_string_field.b",
+				"map_string_string_field.c",
+				"message_field.map_string_message_field.x.map_int32_string_field.1",
+				"message_field.map_string_message_field.y",
+			},
+		},
+		{
+			name: "limits",
+			mask: "map_string_string_field,message_field",
+			opts: []Option{WithMaxMapKeys(1), WithRejectRecursion(true), WithFieldMaxDepth("map_string_message_field", 0)},
+			want: &UpdateReport{
+				DeletedKeys: map[string][]string{
+					"map_string_string_field":                                         {"b", "c"},
+					"message_field.map_string_message_field":                          {"y"},
+					"message_field.map_string_message_field.x.map_int32_string_field": {"1"},
+				},
+			},
+			changed: []string{
+				"map_string_string_field.a",
+				"map_string_string_field.b",
+				"map_string_string_field.c",
+				"message_field.map_string_message_field.x.map_int32_string_field.1",
+				"message_field.map_string_message_field.y",
+			},
+		},
+		{
+			name: "append",
+			mask: "repeated_int32_field,repeated_string_field",
+			opts: []Option{WithUpdateRepeated(UpdateAppendsRepeated)},
+			want: &UpdateReport{
+				AppendedLists: []string{"repeated_string_field", "repeated_int32_field"},
+			},
+			changed: []string{"repeated_int32_field", "repeated_string_field"},
+		},
+		{
+			name: "none",
+			mask: "int32_field",
+			want: &UpdateReport{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := Parse[*testpb.Message](tt.mask, tt.opts...)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %v", err)
+			}
+			dst := newDst()
+			got, changed, err := fm.UpdateReport(dst, src)
+			if err != nil {
+				t.Fatalf("UpdateReport: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("UpdateReport: unexpected report (-want +got):\n%s", diff)
+			}
+			if changed == nil {
+				if tt.changed != nil {
+					t.Fatalf("UpdateReport: got nil changed mask; want %q", tt.changed)
+				}
+			} else {
+				if diff := cmp.Diff(tt.changed, changed.Paths()); diff != "" {
+					t.Errorf("UpdateReport: unexpected changed paths (-want +got):\n%s", diff)
+				}
+				// The changed mask is standalone and reapplies the update.
+				reparsed, err := New[*testpb.Message](changed.Paths())
+				if err != nil {
+					t.Fatalf("New(changed paths): unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(changed.Paths(), reparsed.Paths()); diff != "" {
+					t.Errorf("New(changed paths): unexpected paths (-want +got):\n%s", diff)
+				}
+				reapplied := newDst()
+				if err := changed.Update(reapplied, dst); err != nil {
+					t.Fatalf("Update: unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(dst, reapplied, protocmp.Transform()); diff != "" {
+					t.Errorf("Update(changed): unexpected message (-want +got):\n%s", diff)
+				}
+			}
+			want := newDst()
+			if err := fm.Update(want, src); err != nil {
+				t.Fatalf("Update: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, dst, protocmp.Transform()); diff != "" {
+				t.Errorf("UpdateReport: unexpected message (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
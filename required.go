@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WithRequired returns a new mask that also covers the required fields of every message that the mask
+// selects in part, recursively, so that a message masked or cloned by it is initialized if the original
+// message was, and serializing it succeeds. The required fields are named by Paths, so the mask documents
+// them. A required message field that's already selected in part isn't covered as a whole, but its own
+// required fields are covered. Proto3 messages have no required fields, so the paths are unchanged.
+func (fm *FieldMask[T]) WithRequired() *FieldMask[T] {
+	out := fm.subMask(fm.msg.paths())
+	out.msg.appendRequired()
+	return out
+}
+
+// A requiredAppender is implemented by message field masks that can append the required fields
+// of the messages they select in part.
+type requiredAppender interface {
+	appendRequired()
+}
+
+var (
+	_ requiredAppender = (*msgMask)(nil)
+	_ requiredAppender = (*msgFieldMask)(nil)
+	_ requiredAppender = (*msgListFieldMask)(nil)
+	_ requiredAppender = (*msgMapFieldMask[string])(nil)
+)
+
+func (mm *msgMask) appendRequired() {
+	if mm == nil || mm.complete() {
+		return
+	}
+	for _, m := range mm.anyMasks {
+		m.appendRequired()
+	}
+	for i, n := 0, mm.fldDescs.Len(); i < n; i++ {
+		fd := mm.fldDescs.Get(i)
+		if fd.Cardinality() != protoreflect.Required || !mm.settings.allow(fd) {
+			continue
+		}
+		key := mm.settings.fieldKey(fd)
+		if _, ok := mm.fields[key]; ok {
+			continue
+		}
+		// The field isn't a path of the user's, so it's added as a whole without checking it.
+		mm.setField(key, fd, newFieldMask(mm.settings, fd))
+	}
+	for _, f := range mm.fields {
+		if ra, ok := f.(requiredAppender); ok {
+			ra.appendRequired()
+		}
+	}
+}
+
+func (fm *msgListFieldMask) appendRequired() {
+	fm.wildMask.appendRequired()
+	for _, m := range fm.indexedMasks {
+		m.appendRequired()
+	}
+}
+
+func (fm *msgMapFieldMask[T]) appendRequired() {
+	fm.wildMask.appendRequired()
+	for _, m := range fm.keyedMasks {
+		m.appendRequired()
+	}
+}
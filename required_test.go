@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestWithRequired(t *testing.T) {
+	for _, tt := range []struct {
+		mask  string
+		paths []string
+	}{
+		{
+			mask:  "*",
+			paths: []string{"*"},
+		},
+		{
+			mask:  "name",
+			paths: []string{"id", "info", "name"},
+		},
+		{
+			mask:  "info.string_field",
+			paths: []string{"id", "info.string_field"},
+		},
+		{
+			mask:  "child.name",
+			paths: []string{"child.id", "child.info", "child.name", "id", "info"},
+		},
+		{
+			mask:  "child",
+			paths: []string{"child", "id", "info"},
+		},
+		{
+			mask: "children.*.name,children.1.child.name",
+			paths: []string{
+				"children.*.id",
+				"children.*.info",
+				"children.*.name",
+				"children.1.child.id",
+				"children.1.child.info",
+				"children.1.child.name",
+				"id",
+				"info",
+			},
+		},
+		{
+			mask:  "children_by_name.a.name,children_by_name.b.",
+			paths: []string{"children_by_name.a.id", "children_by_name.a.info", "children_by_name.a.name", "children_by_name.b.id", "children_by_name.b.info", "id", "info"},
+		},
+	} {
+		t.Run(tt.mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Proto2RequiredMessage](tt.mask)
+			if err != nil {
+				t.Fatalf("Failed to parse mask: %q: %v", tt.mask, err)
+			}
+			before := fm.Paths()
+			req := fm.WithRequired()
+			if diff := cmp.Diff(tt.paths, req.Paths()); diff != "" {
+				t.Errorf("WithRequired: unexpected paths diff:\n%s", diff)
+			}
+			if diff := cmp.Diff(before, fm.Paths()); diff != "" {
+				t.Errorf("WithRequired: modified the original mask:\n%s", diff)
+			}
+		})
+	}
+
+	msg := &testpb.Proto2RequiredMessage{
+		Id:    proto.Int32(1),
+		Name:  proto.String("root"),
+		Info:  &testpb.Proto2Message{},
+		Child: &testpb.Proto2RequiredMessage{Id: proto.Int32(2), Name: proto.String("child"), Info: &testpb.Proto2Message{}},
+	}
+	fm, err := Parse[*testpb.Proto2RequiredMessage]("child.name")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if _, err := proto.Marshal(fm.Clone(msg)); err == nil {
+		t.Error("Marshal: expected error for clone without required fields")
+	}
+	if _, err := proto.Marshal(fm.WithRequired().Clone(msg)); err != nil {
+		t.Errorf("Marshal: unexpected error for clone with required fields: %v", err)
+	}
+
+	// Required fields aren't paths of the user's, so they aren't checked for deprecation.
+	var warned []protoreflect.FullName
+	dep, err := Parse[*testpb.Proto2DeprecatedRequiredMessage]("b",
+		WithRejectDeprecated(true),
+		WithWarnDeprecated(func(fd protoreflect.FieldDescriptor) { warned = append(warned, fd.FullName()) }),
+	)
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, dep.WithRequired().Paths()); diff != "" {
+		t.Errorf("WithRequired: unexpected deprecated paths diff:\n%s", diff)
+	}
+	if len(warned) > 0 {
+		t.Errorf("WithRequired: unexpected deprecation warnings: %v", warned)
+	}
+
+	proto3, err := Parse[*testpb.Message]("int32_field,message_field.string_field")
+	if err != nil {
+		t.Fatalf("Failed to parse mask: %v", err)
+	}
+	if diff := cmp.Diff(proto3.Paths(), proto3.WithRequired().Paths()); diff != "" {
+		t.Errorf("WithRequired: unexpected proto3 paths diff:\n%s", diff)
+	}
+}
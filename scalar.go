@@ -15,11 +15,12 @@ import (
 var _ fieldMask = (*scalarFieldMask)(nil)
 
 type scalarFieldMask struct {
-	desc protoreflect.FieldDescriptor
+	desc     protoreflect.FieldDescriptor
+	settings *settings
 }
 
-func newScalarFieldMask(desc protoreflect.FieldDescriptor) *scalarFieldMask {
-	return &scalarFieldMask{desc: desc}
+func newScalarFieldMask(settings *settings, desc protoreflect.FieldDescriptor) *scalarFieldMask {
+	return &scalarFieldMask{desc: desc, settings: settings}
 }
 
 func (fm *scalarFieldMask) complete() bool { return true }
@@ -30,23 +31,30 @@ func (fm *scalarFieldMask) append(path string) error { return addScalarPath(path
 
 func (fm *scalarFieldMask) paths() []string { return nil }
 
-func (fm *scalarFieldMask) mask(protoreflect.Message, protoreflect.Value) { /* no-op */ }
+func (fm *scalarFieldMask) coversPath(path string) bool { return path == "" }
 
-func (fm *scalarFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool) {
+func (fm *scalarFieldMask) mask(protoreflect.Message, protoreflect.Value, string, *callState) { /* no-op */
+}
+
+func (fm *scalarFieldMask) update(parent protoreflect.Message, value protoreflect.Value, exists bool, c *callState) {
+	if !fm.settings.updatesField(fm.desc, value, c) {
+		return
+	}
 	if !exists || !value.IsValid() {
 		parent.Clear(fm.desc)
 		return
 	}
-	parent.Set(fm.desc, value)
+	parent.Set(fm.desc, fm.settings.mergeField(parent, fm.desc, value))
 }
 
-func (fm *scalarFieldMask) clone(parent protoreflect.Message, value protoreflect.Value) protoreflect.Value {
-	if fm.desc.Kind() == protoreflect.BytesKind {
-		return cloneBytesValue(value)
-	}
-	return value
+func (fm *scalarFieldMask) clone(parent protoreflect.Message, value protoreflect.Value, c *callState) protoreflect.Value {
+	return fm.settings.cloneScalar(fm.desc, value, c)
 }
 
+func (fm *scalarFieldMask) covers(value protoreflect.Value, path string) bool { return path == "" }
+
+func (fm *scalarFieldMask) modifies(protoreflect.Value) bool { return false }
+
 func addScalarPath(path string) error {
 	if path != "" {
 		return fmt.Errorf("invalid scalar field subpath: %q", path)
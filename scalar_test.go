@@ -7,9 +7,13 @@
 package fieldmask
 
 import (
+	"math"
+	"slices"
 	"testing"
 
 	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestBool(t *testing.T) {
@@ -187,3 +191,440 @@ func TestBytes(t *testing.T) {
 		err:  true,
 	}.run(t)
 }
+
+func TestLeafMerger(t *testing.T) {
+	merger := WithLeafMerger(func(fd protoreflect.FieldDescriptor, dst, src protoreflect.Value) protoreflect.Value {
+		if !dst.IsValid() {
+			return protoreflect.Value{}
+		}
+		switch fd.Kind() {
+		case protoreflect.Int32Kind:
+			return protoreflect.ValueOfInt32(int32(dst.Int() + src.Int()))
+		case protoreflect.StringKind:
+			return protoreflect.ValueOfString(dst.String() + "+" + src.String())
+		default:
+			return protoreflect.Value{}
+		}
+	})
+	dst := &testpb.Message{
+		Int32Field:           1,
+		BoolField:            true,
+		MapStringStringField: map[string]string{"foo": "a", "bar": "b"},
+		MessageField:         &testpb.Message{Int32Field: 10},
+	}
+	src := &testpb.Message{
+		Int32Field:           2,
+		StringField:          "src",
+		MapStringStringField: map[string]string{"foo": "c", "qux": "d"},
+		MessageField:         &testpb.Message{Int32Field: 20},
+	}
+
+	updateTest{
+		name: "keyed",
+		mask: "int32_field,string_field,bool_field,map_string_string_field.foo,map_string_string_field.qux,message_field.int32_field",
+		opts: []Option{merger},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:           3,
+			StringField:          "src",
+			MapStringStringField: map[string]string{"foo": "a+c", "bar": "b", "qux": "d"},
+			MessageField:         &testpb.Message{Int32Field: 30},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "complete",
+		mask: "map_string_string_field,message_field",
+		opts: []Option{merger},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:           1,
+			BoolField:            true,
+			MapStringStringField: map[string]string{"foo": "a+c", "qux": "d"},
+			MessageField:         &testpb.Message{Int32Field: 30},
+		},
+	}.run(t)
+
+	// Replaced list elements are paired by index, and appended elements have no destination value.
+	listDst := &testpb.Message{
+		RepeatedInt32Field: []int32{1, 2},
+		MessageField:       &testpb.Message{RepeatedInt32Field: []int32{1, 2}},
+	}
+	listSrc := &testpb.Message{
+		RepeatedInt32Field: []int32{10, 20, 30},
+		MessageField:       &testpb.Message{RepeatedInt32Field: []int32{10}},
+	}
+	updateTest{
+		name: "list-replace",
+		mask: "repeated_int32_field,message_field",
+		opts: []Option{merger},
+		dst:  listDst,
+		src:  listSrc,
+		out: &testpb.Message{
+			RepeatedInt32Field: []int32{11, 22, 30},
+			MessageField:       &testpb.Message{RepeatedInt32Field: []int32{11}},
+		},
+	}.run(t)
+	updateTest{
+		name: "list-append",
+		mask: "repeated_int32_field,message_field",
+		opts: []Option{merger, WithUpdateRepeated(UpdateAppendsRepeated)},
+		dst:  listDst,
+		src:  listSrc,
+		out: &testpb.Message{
+			RepeatedInt32Field: []int32{1, 2, 10, 20, 30},
+			MessageField:       &testpb.Message{RepeatedInt32Field: []int32{1, 2, 10}},
+		},
+	}.run(t)
+}
+
+func TestUpdateCondition(t *testing.T) {
+	nonZero := WithUpdateCondition(func(fd protoreflect.FieldDescriptor, src protoreflect.Value) bool {
+		return src.IsValid() && !src.Equal(fd.Default())
+	})
+	dst := &testpb.Message{
+		Int32Field:   1,
+		StringField:  "dst",
+		BoolField:    true,
+		MessageField: &testpb.Message{Int32Field: 10, StringField: "dst"},
+	}
+	src := &testpb.Message{
+		Int32Field:   2,
+		MessageField: &testpb.Message{StringField: "src"},
+	}
+
+	updateTest{
+		name: "keyed",
+		mask: "int32_field,string_field,bool_field,message_field.int32_field,message_field.string_field",
+		opts: []Option{nonZero},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:   2,
+			StringField:  "dst",
+			BoolField:    true,
+			MessageField: &testpb.Message{Int32Field: 10, StringField: "src"},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "complete",
+		mask: "message_field",
+		opts: []Option{nonZero},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:   1,
+			StringField:  "dst",
+			BoolField:    true,
+			MessageField: &testpb.Message{Int32Field: 10, StringField: "src"},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "default",
+		mask: "int32_field,string_field,bool_field",
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:   2,
+			MessageField: dst.MessageField,
+		},
+	}.run(t)
+}
+
+func TestUpdateSkipDefaults(t *testing.T) {
+	skip := WithUpdateSkipDefaults(true)
+	dst := &testpb.Message{
+		Int32Field:        1,
+		StringField:       "dst",
+		BytesField:        []byte("dst"),
+		OneofField:        &testpb.Message_Int32OneofField{Int32OneofField: 2},
+		WrappedInt32Value: wrapperspb.Int32(3),
+		MessageField:      &testpb.Message{Int32Field: 10, StringField: "dst"},
+	}
+	src := &testpb.Message{
+		StringField:       "src",
+		OneofField:        &testpb.Message_Int32OneofField{},
+		WrappedInt32Value: wrapperspb.Int32(0),
+		MessageField:      &testpb.Message{StringField: "src"},
+	}
+
+	updateTest{
+		name: "keyed",
+		mask: "int32_field,string_field,bytes_field,int32_oneof_field,wrapped_int32_value,message_field.int32_field",
+		opts: []Option{skip},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:        1,
+			StringField:       "src",
+			BytesField:        []byte("dst"),
+			OneofField:        &testpb.Message_Int32OneofField{},
+			WrappedInt32Value: wrapperspb.Int32(0),
+			MessageField:      &testpb.Message{Int32Field: 10, StringField: "dst"},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "complete",
+		mask: "message_field",
+		opts: []Option{skip},
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			Int32Field:        1,
+			StringField:       "dst",
+			BytesField:        []byte("dst"),
+			OneofField:        &testpb.Message_Int32OneofField{Int32OneofField: 2},
+			WrappedInt32Value: wrapperspb.Int32(3),
+			MessageField:      &testpb.Message{Int32Field: 10, StringField: "src"},
+		},
+	}.run(t)
+
+	updateTest{
+		name: "default",
+		mask: "int32_field,bytes_field",
+		dst:  dst,
+		src:  src,
+		out: &testpb.Message{
+			StringField:       "dst",
+			OneofField:        &testpb.Message_Int32OneofField{Int32OneofField: 2},
+			WrappedInt32Value: wrapperspb.Int32(3),
+			MessageField:      dst.MessageField,
+		},
+	}.run(t)
+}
+
+func TestCloneTransform(t *testing.T) {
+	redact := WithCloneTransform(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+		kind := fd.Kind()
+		if fd.IsMap() {
+			kind = fd.MapValue().Kind()
+		}
+		switch kind {
+		case protoreflect.StringKind:
+			return protoreflect.ValueOfString("redacted")
+		case protoreflect.BytesKind:
+			b := v.Bytes()
+			for i := range b {
+				b[i] = 0
+			}
+		}
+		return v
+	})
+	src := &testpb.Message{
+		Int32Field:           1,
+		StringField:          "secret",
+		BytesField:           []byte("secret"),
+		RepeatedStringField:  []string{"a", "b"},
+		MapStringStringField: map[string]string{"a": "b"},
+		MessageField:         &testpb.Message{StringField: "secret", Int32Field: 2},
+	}
+	orig := clone(src)
+
+	for _, tt := range []struct {
+		mask string
+		out  *testpb.Message
+	}{
+		{
+			mask: "int32_field,string_field,bytes_field,repeated_string_field,map_string_string_field.a",
+			out: &testpb.Message{
+				Int32Field:           1,
+				StringField:          "redacted",
+				BytesField:           make([]byte, 6),
+				RepeatedStringField:  []string{"redacted", "redacted"},
+				MapStringStringField: map[string]string{"a": "redacted"},
+			},
+		},
+		{
+			mask: "message_field",
+			out:  &testpb.Message{MessageField: &testpb.Message{StringField: "redacted", Int32Field: 2}},
+		},
+		{
+			mask: "map_string_string_field",
+			out:  &testpb.Message{MapStringStringField: map[string]string{"a": "redacted"}},
+		},
+	} {
+		fm, err := Parse[*testpb.Message](tt.mask, redact)
+		if err != nil {
+			t.Fatalf("Unexpected error parsing mask: %q: %v", tt.mask, err)
+		}
+		if diff := protoDiff(tt.out, fm.Clone(src)); diff != "" {
+			t.Errorf("Clone(%q): unexpected diff:\n%s", tt.mask, diff)
+		}
+		if diff := protoDiff(orig, src); diff != "" {
+			t.Fatalf("Clone(%q): modified source:\n%s", tt.mask, diff)
+		}
+	}
+
+	updateTest{
+		name: "update",
+		mask: "string_field,bytes_field,message_field",
+		opts: []Option{redact},
+		dst:  &testpb.Message{},
+		src:  src,
+		out: &testpb.Message{
+			StringField:  src.StringField,
+			BytesField:   src.BytesField,
+			MessageField: src.MessageField,
+		},
+	}.run(t)
+}
+
+func TestSanitizeFloats(t *testing.T) {
+	sanitize := WithSanitizeFloats(true)
+	nan, inf := math.NaN(), math.Inf(1)
+	msg := &testpb.Message{
+		Int32Field:           1,
+		DoubleField:          nan,
+		FloatField:           float32(-inf),
+		RepeatedDoubleField:  []float64{1, nan, inf, 2},
+		MapStringDoubleField: map[string]float64{"a": 1, "b": nan, "c": -inf},
+		MessageField:         &testpb.Message{DoubleField: inf, FloatField: 3},
+	}
+	sanitized := &testpb.Message{
+		Int32Field:           1,
+		RepeatedDoubleField:  []float64{1, 2},
+		MapStringDoubleField: map[string]float64{"a": 1},
+		MessageField:         &testpb.Message{FloatField: 3},
+	}
+
+	for _, tt := range []basicTest{
+		{
+			name:  "complete",
+			mask:  "*",
+			paths: []string{"*"},
+			out:   sanitized,
+		},
+		{
+			name:  "fields",
+			mask:  "double_field,float_field,repeated_double_field,map_string_double_field,message_field",
+			paths: []string{"double_field", "float_field", "map_string_double_field", "message_field", "repeated_double_field"},
+			out: &testpb.Message{
+				RepeatedDoubleField:  sanitized.RepeatedDoubleField,
+				MapStringDoubleField: sanitized.MapStringDoubleField,
+				MessageField:         sanitized.MessageField,
+			},
+		},
+		{
+			name:  "keys",
+			mask:  "map_string_double_field.a,map_string_double_field.b,message_field.double_field",
+			paths: []string{"map_string_double_field.a", "map_string_double_field.b", "message_field.double_field"},
+			out: &testpb.Message{
+				MapStringDoubleField: map[string]float64{"a": 1},
+				MessageField:         &testpb.Message{},
+			},
+		},
+	} {
+		tt.opts = []Option{sanitize}
+		tt.msg = msg
+		tt.run(t)
+	}
+
+	// Infinite values are retained by default.
+	inMsg := &testpb.Message{FloatField: float32(inf), RepeatedDoubleField: []float64{-inf}}
+	basicTest{
+		name:  "default",
+		mask:  "float_field,repeated_double_field",
+		paths: []string{"float_field", "repeated_double_field"},
+		msg:   inMsg,
+		out:   inMsg,
+	}.run(t)
+}
+
+func TestEnumValueFilter(t *testing.T) {
+	const (
+		red   = testpb.Proto2Message_RED
+		green = testpb.Proto2Message_GREEN
+		blue  = testpb.Proto2Message_BLUE
+	)
+	filters := []Option{
+		WithEnumValueFilter("enum_field", []protoreflect.EnumNumber{red.Number(), green.Number()}),
+		WithEnumValueFilter("repeated_enum_field", []protoreflect.EnumNumber{red.Number()}),
+		WithEnumValueFilter("map_string_enum_field", []protoreflect.EnumNumber{green.Number()}),
+	}
+	msg := &testpb.Proto2Message{
+		EnumField:         blue.Enum(),
+		RepeatedEnumField: []testpb.Proto2Message_Color{red, blue, red, green},
+		MapStringEnumField: map[string]testpb.Proto2Message_Color{
+			"a": red,
+			"b": green,
+			"c": blue,
+		},
+		MessageField: &testpb.Proto2Message{
+			EnumField: green.Enum(),
+		},
+	}
+	filtered := &testpb.Proto2Message{
+		RepeatedEnumField:  []testpb.Proto2Message_Color{red, red},
+		MapStringEnumField: map[string]testpb.Proto2Message_Color{"b": green},
+		MessageField: &testpb.Proto2Message{
+			EnumField: green.Enum(),
+		},
+	}
+	for _, tt := range []struct {
+		mask string
+		out  *testpb.Proto2Message
+	}{
+		{mask: "*", out: filtered},
+		{mask: "enum_field,repeated_enum_field,map_string_enum_field,message_field", out: filtered},
+		{
+			mask: "enum_field,map_string_enum_field.b,map_string_enum_field.c",
+			out: &testpb.Proto2Message{
+				MapStringEnumField: map[string]testpb.Proto2Message_Color{"b": green},
+			},
+		},
+		{
+			mask: "message_field.enum_field",
+			out: &testpb.Proto2Message{
+				MessageField: &testpb.Proto2Message{EnumField: green.Enum()},
+			},
+		},
+	} {
+		t.Run(tt.mask, func(t *testing.T) {
+			fm, err := Parse[*testpb.Proto2Message](tt.mask, filters...)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error: %v", err)
+			}
+			masked := clone(msg)
+			fm.Mask(masked)
+			if diff := protoDiff(tt.out, masked); diff != "" {
+				t.Errorf("Mask: unexpected diff:\n%s", diff)
+			}
+			if diff := protoDiff(tt.out, fm.Clone(msg)); diff != "" {
+				t.Errorf("Clone: unexpected diff:\n%s", diff)
+			}
+		})
+	}
+
+	var cleared []string
+	fm, err := Parse[*testpb.Proto2Message]("*", append(filters, WithOnClear(func(path string, _ protoreflect.FieldDescriptor) {
+		cleared = append(cleared, path)
+	}))...)
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	fm.Mask(clone(msg))
+	slices.Sort(cleared)
+	if want := []string{"enum_field", "map_string_enum_field.a", "map_string_enum_field.c"}; !slices.Equal(cleared, want) {
+		t.Errorf("OnClear: got: %q; want: %q", cleared, want)
+	}
+
+	for _, path := range []string{"int32_field", "unknown_field", "repeated_message_field.enum_field"} {
+		if _, err := Parse[*testpb.Proto2Message]("*", WithEnumValueFilter(path, nil)); err == nil {
+			t.Errorf("Parse: expected error for enum value filter path: %q", path)
+		}
+	}
+
+	other, err := Parse[*testpb.Proto2Message]("enum_field", WithEnumValueFilter("enum_field", []protoreflect.EnumNumber{blue.Number()}))
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	const want = "incompatible mask settings: mismatched enum value filters"
+	if _, err := fm.Union(other); err == nil || err.Error() != want {
+		t.Errorf("Union: unexpected error: got: %v; want: %v", err, want)
+	}
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"fmt"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A Selection describes the fields of a message that are selected by a field mask.
+type Selection struct {
+	// Complete indicates if the whole message is selected.
+	// If so, Fields and Packed are empty.
+	Complete bool
+	// Fields holds the selections of the selected fields, ordered by field number.
+	// If the selection isn't complete and it's empty, none of the fields are selected.
+	Fields []*FieldSelection
+	// Packed holds the selections of the messages packed in a google.protobuf.Any,
+	// keyed by the full names of their types.
+	Packed map[protoreflect.FullName]*Selection
+}
+
+// A FieldSelection describes the selection of the value of a field.
+type FieldSelection struct {
+	// Field is the descriptor of the field.
+	Field protoreflect.FieldDescriptor
+	// Complete indicates if the whole value of the field is selected.
+	// If so, the other selections are empty.
+	Complete bool
+	// Message is the selection of the fields of a singular message field.
+	Message *Selection
+	// Slice is the range of elements selected from a list field, if any.
+	Slice *SliceSelection
+	// Wild is the selection of the fields of every element of a message list field,
+	// or every value of a message map field, if any. If there's a slice, it's the
+	// selection of the fields of every element selected by the slice.
+	Wild *Selection
+	// Indexes holds the selections of the fields of the elements of a message list
+	// field at specific indexes. They include the fields selected by Wild.
+	Indexes map[int]*Selection
+	// Keys holds the selections of specific keys of a map field, ordered by key.
+	Keys []*KeySelection
+}
+
+// A SliceSelection describes a contiguous range of the elements of a list.
+// Like a slice expression, the start is inclusive and the end is exclusive,
+// and a negative index counts back from the end of the list.
+type SliceSelection struct {
+	Start, End       int
+	HasStart, HasEnd bool
+}
+
+// A KeySelection describes the selection of a key of a map field.
+type KeySelection struct {
+	// Key is the selected key.
+	Key protoreflect.MapKey
+	// Value is the selection of the fields of the value of a message map field.
+	// It's nil if the values of the map are scalars.
+	Value *Selection
+}
+
+// Selection returns a tree that describes the selection of the field mask.
+// It's a structured alternative to Paths for programs that inspect the mask,
+// with typed map keys and list indexes. The selection is a copy and may be
+// modified without affecting the field mask.
+func (fm *FieldMask[T]) Selection() *Selection {
+	return fm.msg.selection()
+}
+
+// A selector is implemented by field masks that can describe their selections.
+type selector interface {
+	fieldSelection() *FieldSelection
+}
+
+var (
+	_ selector = (*msgFieldMask)(nil)
+	_ selector = (*scalarFieldMask)(nil)
+	_ selector = (*scalarListFieldMask)(nil)
+	_ selector = (*msgListFieldMask)(nil)
+	_ selector = (*scalarMapFieldMask[string])(nil)
+	_ selector = (*msgMapFieldMask[string])(nil)
+)
+
+// selection returns the selection of the message mask, where nil is complete.
+func (mm *msgMask) selection() *Selection {
+	if mm == nil || mm.complete() {
+		return &Selection{Complete: true}
+	}
+	sel := &Selection{}
+	if len(mm.anyMasks) > 0 {
+		sel.Packed = make(map[protoreflect.FullName]*Selection, len(mm.anyMasks))
+		for name, m := range mm.anyMasks {
+			sel.Packed[name] = m.selection()
+		}
+	}
+	for _, f := range mm.fields {
+		sel.Fields = append(sel.Fields, f.(selector).fieldSelection())
+	}
+	slices.SortFunc(sel.Fields, func(a, b *FieldSelection) int {
+		return int(a.Field.Number()) - int(b.Field.Number())
+	})
+	return sel
+}
+
+func (fm *msgFieldMask) fieldSelection() *FieldSelection {
+	if fm.complete() {
+		return &FieldSelection{Field: fm.desc, Complete: true}
+	}
+	return &FieldSelection{Field: fm.desc, Message: fm.msgMask.selection()}
+}
+
+func (fm *scalarFieldMask) fieldSelection() *FieldSelection {
+	return &FieldSelection{Field: fm.desc, Complete: true}
+}
+
+func (fm *scalarListFieldMask) fieldSelection() *FieldSelection {
+	if fm.complete() {
+		return &FieldSelection{Field: fm.desc, Complete: true}
+	}
+	return &FieldSelection{Field: fm.desc, Slice: fm.slice.selection()}
+}
+
+func (fm *msgListFieldMask) fieldSelection() *FieldSelection {
+	if fm.complete() {
+		return &FieldSelection{Field: fm.desc, Complete: true}
+	}
+	sel := &FieldSelection{Field: fm.desc}
+	if fm.slice != nil {
+		sel.Slice = fm.slice.selection()
+	}
+	if fm.wildMask != nil {
+		sel.Wild = fm.wildMask.selection()
+	}
+	if fm.indexedMasks != nil {
+		sel.Indexes = make(map[int]*Selection, len(fm.indexedMasks))
+		for i, m := range fm.indexedMasks {
+			sel.Indexes[i] = m.selection()
+		}
+	}
+	return sel
+}
+
+func (fm *scalarMapFieldMask[T]) fieldSelection() *FieldSelection {
+	if fm.complete() {
+		return &FieldSelection{Field: fm.desc, Complete: true}
+	}
+	keys := maps.Keys(fm.keys)
+	slices.Sort(keys)
+	sel := &FieldSelection{Field: fm.desc}
+	for _, key := range keys {
+		sel.Keys = append(sel.Keys, &KeySelection{Key: mapKeyOf(key)})
+	}
+	return sel
+}
+
+func (fm *msgMapFieldMask[T]) fieldSelection() *FieldSelection {
+	if fm.complete() {
+		return &FieldSelection{Field: fm.desc, Complete: true}
+	}
+	sel := &FieldSelection{Field: fm.desc}
+	if fm.wildMask != nil {
+		sel.Wild = fm.wildMask.selection()
+	}
+	keys := maps.Keys(fm.keyedMasks)
+	slices.Sort(keys)
+	for _, key := range keys {
+		sel.Keys = append(sel.Keys, &KeySelection{
+			Key:   mapKeyOf(key),
+			Value: fm.keyedMasks[key].selection(),
+		})
+	}
+	return sel
+}
+
+func (s *listSlice) selection() *SliceSelection {
+	return &SliceSelection{
+		Start:    s.start,
+		End:      s.end,
+		HasStart: s.hasStart,
+		HasEnd:   s.hasEnd,
+	}
+}
+
+// mapKeyOf returns the map key of the stored key of a map field mask.
+func mapKeyOf[T constraints.Ordered](key T) protoreflect.MapKey {
+	switch k := any(key).(type) {
+	case string:
+		return protoreflect.ValueOfString(k).MapKey()
+	case byte:
+		return protoreflect.ValueOfBool(k != 0).MapKey()
+	case int32:
+		return protoreflect.ValueOfInt32(k).MapKey()
+	case int64:
+		return protoreflect.ValueOfInt64(k).MapKey()
+	case uint32:
+		return protoreflect.ValueOfUint32(k).MapKey()
+	case uint64:
+		return protoreflect.ValueOfUint64(k).MapKey()
+	default:
+		panic(fmt.Sprintf("fieldmask: internal error: invalid map key type: %T", key))
+	}
+}
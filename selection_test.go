@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strings"
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var selectionCmp = cmp.Options{
+	cmp.Comparer(func(a, b protoreflect.FieldDescriptor) bool { return a.FullName() == b.FullName() }),
+	cmp.Comparer(func(a, b protoreflect.MapKey) bool { return a.Interface() == b.Interface() }),
+}
+
+func TestSelection(t *testing.T) {
+	fields := (&testpb.Message{}).ProtoReflect().Descriptor().Fields()
+	fd := func(name protoreflect.Name) protoreflect.FieldDescriptor { return fields.ByName(name) }
+	complete := &Selection{Complete: true}
+	only := func(names ...protoreflect.Name) *Selection {
+		sel := &Selection{}
+		for _, name := range names {
+			sel.Fields = append(sel.Fields, &FieldSelection{Field: fd(name), Complete: true})
+		}
+		return sel
+	}
+	tests := []struct {
+		name string
+		mask string
+		want *Selection
+	}{
+		{
+			name: "complete",
+			mask: "*",
+			want: complete,
+		},
+		{
+			name: "fields",
+			mask: "message_field.string_field,int32_field,message_field.message_field",
+			want: &Selection{Fields: []*FieldSelection{
+				{Field: fd("int32_field"), Complete: true},
+				{Field: fd("message_field"), Message: only("string_field", "message_field")},
+			}},
+		},
+		{
+			name: "lists",
+			mask: "repeated_int32_field[-3:],repeated_message_field.*.int32_field,repeated_message_field.1,repeated_string_field",
+			want: &Selection{Fields: []*FieldSelection{
+				{Field: fd("repeated_string_field"), Complete: true},
+				{Field: fd("repeated_int32_field"), Slice: &SliceSelection{Start: -3, HasStart: true}},
+				{
+					Field:   fd("repeated_message_field"),
+					Wild:    only("int32_field"),
+					Indexes: map[int]*Selection{1: complete},
+				},
+			}},
+		},
+		{
+			name: "list slice",
+			mask: "repeated_message_field[1:2].string_field",
+			want: &Selection{Fields: []*FieldSelection{{
+				Field: fd("repeated_message_field"),
+				Slice: &SliceSelection{Start: 1, End: 2, HasStart: true, HasEnd: true},
+				Wild:  only("string_field"),
+			}}},
+		},
+		{
+			name: "maps",
+			mask: "map_int32_string_field.7,map_int32_string_field.-1,map_bool_string_field.true,map_string_message_field.*.int32_field,map_string_message_field.b.string_field,map_int32_message_field.3.",
+			want: &Selection{Fields: []*FieldSelection{
+				{Field: fd("map_bool_string_field"), Keys: []*KeySelection{
+					{Key: protoreflect.ValueOfBool(true).MapKey()},
+				}},
+				{Field: fd("map_int32_string_field"), Keys: []*KeySelection{
+					{Key: protoreflect.ValueOfInt32(-1).MapKey()},
+					{Key: protoreflect.ValueOfInt32(7).MapKey()},
+				}},
+				{Field: fd("map_string_message_field"), Wild: only("int32_field"), Keys: []*KeySelection{
+					{Key: protoreflect.ValueOfString("b").MapKey(), Value: only("string_field", "int32_field")},
+				}},
+				{Field: fd("map_int32_message_field"), Keys: []*KeySelection{
+					{Key: protoreflect.ValueOfInt32(3).MapKey(), Value: &Selection{}},
+				}},
+			}},
+		},
+		{
+			name: "any",
+			mask: "any_field.(dev.bursavich.fieldmask.test.Message).int32_field",
+			want: &Selection{Fields: []*FieldSelection{{
+				Field: fd("any_field"),
+				Message: &Selection{Packed: map[protoreflect.FullName]*Selection{
+					"dev.bursavich.fieldmask.test.Message": only("int32_field"),
+				}},
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, err := New[*testpb.Message](strings.Split(tt.mask, ","))
+			if err != nil {
+				t.Fatalf("New(%q): %v", tt.mask, err)
+			}
+			if diff := cmp.Diff(tt.want, fm.Selection(), selectionCmp); diff != "" {
+				t.Errorf("Selection(): (-want +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,185 +7,1746 @@
 package fieldmask
 
 import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"bursavich.dev/fieldmask/internal/quote"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-type fieldLookupFunc func(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool)
+type fieldLookupFunc func(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool)
+
+func lookupTextField(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
+	fd = fields.ByTextName(name)
+	if fd == nil {
+		fd = fields.ByJSONName(name)
+	}
+	if fd == nil {
+		return "", nil, false
+	}
+	return fd.TextName(), fd, true
+}
+
+func lookupTextFieldStrict(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
+	fd = fields.ByTextName(name)
+	if fd == nil || fd.TextName() != name {
+		return "", nil, false
+	}
+	return fd.TextName(), fd, true
+}
+
+func lookupJSONField(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
+	fd = fields.ByJSONName(name)
+	if fd == nil {
+		fd = fields.ByTextName(name)
+	}
+	if fd == nil {
+		return "", nil, false
+	}
+	return fd.JSONName(), fd, true
+}
+
+func lookupJSONFieldStrict(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
+	fd = fields.ByJSONName(name)
+	if fd == nil || fd.JSONName() != name {
+		return "", nil, false
+	}
+	return fd.JSONName(), fd, true
+}
+
+// A subsetMask is a parent mask that covers every path of a mask.
+type subsetMask struct {
+	settings *settings
+	covers   func(path string) bool
+}
+
+type mapKeyFilterPath struct {
+	path   string
+	filter func(protoreflect.MapKey) bool
+}
+
+type mapKeyTransformPath struct {
+	path string
+	mapKeyTransform
+}
+
+type mapKeyTransform struct {
+	toKey   func(string) (string, error)
+	fromKey func(string) string
+}
+
+type enumFilterPath struct {
+	path    string
+	allowed []protoreflect.EnumNumber
+}
+
+type distinctByPath struct {
+	path     string
+	subfield string
+}
+
+type fieldMaxDepthPath struct {
+	path  string
+	depth int
+}
+
+type mapValueUpdatePath struct {
+	path string
+	mode MapValueUpdate
+	keys []string
+}
+
+type mapValueUpdate struct {
+	keys map[any]MapValueUpdate
+	wild MapValueUpdate
+}
+
+type settings struct {
+	rootDesc    protoreflect.MessageDescriptor
+	extensions  bool
+	extResolver protoregistry.ExtensionTypeResolver
+	fieldFilter func(protoreflect.FieldDescriptor) bool
+
+	defaultMaskExt protoreflect.ExtensionType
+
+	fieldName      FieldName
+	strictNames    bool
+	lookupField    fieldLookupFunc
+	pathSep        rune
+	spaceSeparated bool
+	globExpansion  bool
+	maxMapKeys     int
+	boolKeyStyle   BoolKeyStyle
+	keyEncoding    KeyEncoding
+	diffPresence   DiffPresence
+
+	aliases                  map[string]string
+	alwaysInclude            []string
+	rejectDeprecated         bool
+	rejectRecursion          bool
+	rejectOverrides          bool
+	rejectScalarListSubpaths bool
+	subsetOf                 *subsetMask
+	warnDeprecated           func(protoreflect.FieldDescriptor)
+
+	state *parseState // never shared by copies of the settings
+
+	fieldMaxDepthPaths []fieldMaxDepthPath
+	fieldMaxDepths     map[protoreflect.FieldDescriptor]int
+
+	mapKeyFilterPaths []mapKeyFilterPath
+	mapKeyFilters     map[protoreflect.FieldDescriptor]func(protoreflect.MapKey) bool
+
+	mapKeyTransformPaths []mapKeyTransformPath
+	mapKeyTransforms     map[protoreflect.FieldDescriptor]mapKeyTransform
+
+	enumFilterPaths []enumFilterPath
+	enumFilters     map[protoreflect.FieldDescriptor]map[protoreflect.EnumNumber]bool
+
+	mapValueUpdatePaths []mapValueUpdatePath
+	mapValueUpdates     map[protoreflect.FieldDescriptor]*mapValueUpdate
+
+	distinctByPaths []distinctByPath
+	distinctBy      map[protoreflect.FieldDescriptor]protoreflect.FieldDescriptor // message list field -> subfield
+
+	maskUnknowns        MaskUnknowns
+	retainUnknowns      map[protoreflect.FieldNumber]bool
+	dropUnknowns        map[protoreflect.FullName]bool
+	cloneDedupRepeated  bool
+	deterministicOrder  bool
+	omitEmptyContainers bool
+	dropEmptyMapValues  bool
+	dropEmptyMessages   bool
+	keyedOverridesWild  bool
+	sanitizeFloats      bool
+	onClear             func(path string, fd protoreflect.FieldDescriptor)
+	cloneTransform      func(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value
+
+	updateSettings
+	requirePresent  bool
+	mapDeletePrefix string
+	leafMerger      func(fd protoreflect.FieldDescriptor, dst, src protoreflect.Value) protoreflect.Value
+	updateCondition func(fd protoreflect.FieldDescriptor, src protoreflect.Value) bool
+	onUpdateField   func(path string, fd protoreflect.FieldDescriptor, cleared bool)
+}
+
+// updateSettings are the settings that may be overridden for a single call to UpdateWith.
+type updateSettings struct {
+	updateUnknowns            UpdateUnknowns
+	updateRepeated            UpdateRepeated
+	updateClearsEmptyMaps     bool
+	updateClearsEmptyLists    bool
+	updatePrunesEmptyMessages bool
+	updateWrapperPresence     bool
+	updateReplacesMessages    bool
+	updateSkipsDefaults       bool
+}
+
+// resetUpdates restores the default update behavior.
+func (s *settings) resetUpdates() {
+	s.updateSettings = updateSettings{}
+	s.requirePresent = false
+	s.mapDeletePrefix = ""
+	s.leafMerger = nil
+	s.updateCondition = nil
+	s.mapValueUpdatePaths = nil
+	s.mapValueUpdates = nil
+}
+
+// validPathSep returns a value indicating if the rune may separate the segments of a path.
+// It must not be a character that has another meaning in paths, such as commas between paths,
+// wildcards, quotes, field numbers, packed types of a google.protobuf.Any, or list slices.
+func validPathSep(sep rune) bool {
+	switch sep {
+	case ',', '*', '`', '#', '(', ')', '[', ']', ':':
+		return false
+	}
+	return utf8.ValidRune(sep) && strconv.IsPrint(sep)
+}
+
+// fieldKey returns the name by which the field is keyed in a message mask.
+func (s *settings) fieldKey(fd protoreflect.FieldDescriptor) string {
+	if fd.IsExtension() {
+		return s.quoteKey(string(fd.FullName()))
+	}
+	if s.fieldName == JSONFieldName {
+		return fd.JSONName()
+	}
+	return fd.TextName()
+}
+
+// init resolves any settings that depend on the root descriptor.
+func (s *settings) init() error {
+	if !validPathSep(s.pathSep) {
+		return fmt.Errorf("invalid path separator: %q", s.pathSep)
+	}
+	if s.spaceSeparated && unicode.IsSpace(s.pathSep) {
+		return fmt.Errorf("invalid path separator for space-separated paths: %q", s.pathSep)
+	}
+	if xt := s.defaultMaskExt; xt != nil {
+		xd := xt.TypeDescriptor()
+		if xd.ContainingMessage().FullName() != "google.protobuf.MessageOptions" || !xd.IsList() || xd.Kind() != protoreflect.StringKind {
+			return fmt.Errorf("invalid default mask extension: %v is not a repeated string message option", xd.FullName())
+		}
+	}
+	if p := s.subsetOf; p != nil {
+		switch {
+		case p.settings.rootDesc.FullName() != s.rootDesc.FullName():
+			return fmt.Errorf("mismatched parent mask type: got %v; want %v", p.settings.rootDesc.FullName(), s.rootDesc.FullName())
+		case p.settings.fieldName != s.fieldName || p.settings.pathSep != s.pathSep ||
+			p.settings.boolKeyStyle != s.boolKeyStyle || p.settings.keyEncoding != s.keyEncoding:
+			return fmt.Errorf("incompatible parent mask: mismatched field names, path separator, or map keys")
+		}
+	}
+	for _, kf := range s.mapKeyFilterPaths {
+		fd, err := s.lookupFieldPath(kf.path)
+		if err != nil {
+			return err
+		}
+		if !fd.IsMap() {
+			return fmt.Errorf("invalid map key filter path: %q is not a map", kf.path)
+		}
+		if s.mapKeyFilters == nil {
+			s.mapKeyFilters = make(map[protoreflect.FieldDescriptor]func(protoreflect.MapKey) bool)
+		}
+		s.mapKeyFilters[fd] = kf.filter
+	}
+	for _, kt := range s.mapKeyTransformPaths {
+		fd, err := s.lookupFieldPath(kt.path)
+		if err != nil {
+			return err
+		}
+		if !fd.IsMap() || fd.MapKey().Kind() != protoreflect.StringKind {
+			return fmt.Errorf("invalid map key transform path: %q is not a string-keyed map", kt.path)
+		}
+		if s.mapKeyTransforms == nil {
+			s.mapKeyTransforms = make(map[protoreflect.FieldDescriptor]mapKeyTransform)
+		}
+		s.mapKeyTransforms[fd] = kt.mapKeyTransform
+	}
+	for _, ef := range s.enumFilterPaths {
+		fd, err := s.lookupFieldPath(ef.path)
+		if err != nil {
+			return err
+		}
+		if enumValue(fd) == nil {
+			return fmt.Errorf("invalid enum value filter path: %q is not an enum", ef.path)
+		}
+		if s.enumFilters == nil {
+			s.enumFilters = make(map[protoreflect.FieldDescriptor]map[protoreflect.EnumNumber]bool)
+		}
+		allowed := make(map[protoreflect.EnumNumber]bool, len(ef.allowed))
+		for _, n := range ef.allowed {
+			allowed[n] = true
+		}
+		s.enumFilters[fd] = allowed
+	}
+	for _, db := range s.distinctByPaths {
+		fd, err := s.lookupFieldPath(db.path)
+		if err != nil {
+			return err
+		}
+		if !fd.IsList() || fd.Message() == nil {
+			return fmt.Errorf("invalid distinct-by path: %q is not a repeated message", db.path)
+		}
+		_, sub, ok := s.lookupField(fd.Message().Fields(), db.subfield)
+		if !ok {
+			return s.unknownFieldError(fd.Message(), db.subfield)
+		}
+		if sub.IsList() || sub.IsMap() || sub.Message() != nil {
+			return fmt.Errorf("invalid distinct-by subfield: %q is not a singular scalar field of %v", db.subfield, fd.Message().FullName())
+		}
+		if s.distinctBy == nil {
+			s.distinctBy = make(map[protoreflect.FieldDescriptor]protoreflect.FieldDescriptor)
+		}
+		s.distinctBy[fd] = sub
+	}
+	for _, fmd := range s.fieldMaxDepthPaths {
+		fd, err := s.lookupFieldPath(fmd.path)
+		if err != nil {
+			return err
+		}
+		if fmd.depth < 0 {
+			return fmt.Errorf("invalid max depth for %q: %d", fmd.path, fmd.depth)
+		}
+		if s.fieldMaxDepths == nil {
+			s.fieldMaxDepths = make(map[protoreflect.FieldDescriptor]int)
+		}
+		s.fieldMaxDepths[fd] = fmd.depth
+	}
+	for external, internal := range s.aliases {
+		if external == "" || external == "*" {
+			return fmt.Errorf("invalid alias: %q", external)
+		}
+		if err := newMsgMask(s, s.rootDesc).init(internal); err != nil {
+			return fmt.Errorf("invalid alias %q for path %q: %v", external, internal, err)
+		}
+		if err := newMsgMask(s, s.rootDesc).init(external); err == nil {
+			return fmt.Errorf("invalid alias %q: shadows an existing path", external)
+		}
+	}
+	for _, path := range s.alwaysInclude {
+		if err := newMsgMask(s, s.rootDesc).init(s.unalias(path)); err != nil {
+			return fmt.Errorf("invalid always-included path %q: %v", path, err)
+		}
+	}
+	for _, vu := range s.mapValueUpdatePaths {
+		fd, err := s.lookupFieldPath(vu.path)
+		if err != nil {
+			return err
+		}
+		if !fd.IsMap() || !isMessage(fd.MapValue().Kind()) {
+			return fmt.Errorf("invalid map value update path: %q is not a map of messages", vu.path)
+		}
+		if s.mapValueUpdates == nil {
+			s.mapValueUpdates = make(map[protoreflect.FieldDescriptor]*mapValueUpdate)
+		}
+		u, ok := s.mapValueUpdates[fd]
+		if !ok {
+			u = &mapValueUpdate{keys: make(map[any]MapValueUpdate)}
+			s.mapValueUpdates[fd] = u
+		}
+		for _, key := range vu.keys {
+			if key == "*" {
+				u.wild = vu.mode
+				continue
+			}
+			k, err := s.parseMapKey(fd, key)
+			if err != nil {
+				return fmt.Errorf("invalid map value update key for %q: %q: %v", vu.path, key, err)
+			}
+			u.keys[k] = vu.mode
+		}
+	}
+	return nil
+}
+
+// unalias returns the path with its longest external alias prefix replaced by the internal path prefix.
+func (s *settings) unalias(path string) string {
+	if s.aliases == nil {
+		return path
+	}
+	var external, internal string
+	for k, v := range s.aliases {
+		if len(k) > len(external) && hasPathPrefix(path, k, s.pathSep) {
+			external, internal = k, v
+		}
+	}
+	if external == "" {
+		return path
+	}
+	return internal + path[len(external):]
+}
+
+func (s *settings) unaliasPaths(paths []string) []string {
+	if s.aliases == nil {
+		return paths
+	}
+	out := make([]string, len(paths))
+	for i, path := range paths {
+		out[i] = s.unalias(path)
+	}
+	return out
+}
+
+// aliasPaths replaces the longest internal path prefix of each path with its external alias.
+// If there are multiple aliases for an internal path prefix, the least external alias is used.
+func (s *settings) aliasPaths(paths []string) []string {
+	if s.aliases == nil {
+		return paths
+	}
+	for i, path := range paths {
+		var external, internal string
+		for k, v := range s.aliases {
+			if !hasPathPrefix(path, v, s.pathSep) {
+				continue
+			}
+			if len(v) > len(internal) || (len(v) == len(internal) && k < external) {
+				external, internal = k, v
+			}
+		}
+		if external != "" {
+			paths[i] = external + path[len(internal):]
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// parseMapKey parses the path segment as a key of the map field and returns its interface value.
+func (s *settings) parseMapKey(fd protoreflect.FieldDescriptor, segment string) (any, error) {
+	switch kind := fd.MapKey().Kind(); kind {
+	case protoreflect.StringKind:
+		fn := s.stringKeyFuncs(fd)
+		return fn.key(segment)
+	case protoreflect.BoolKind:
+		fn := s.boolKeyFuncs()
+		b, err := fn.key(segment)
+		return b != 0, err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return int32KeyFuncs.key(segment)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return int64KeyFuncs.key(segment)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return uint32KeyFuncs.key(segment)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return uint64KeyFuncs.key(segment)
+	default:
+		return nil, fmt.Errorf("invalid map key kind: %v", kind)
+	}
+}
+
+// presentMapKey parses the next segment of the path as a key of the map field and
+// returns the key if it's present in the map and passes any key filter.
+func (s *settings) presentMapKey(fd protoreflect.FieldDescriptor, m protoreflect.Map, path string) (key protoreflect.MapKey, subpath string, ok bool) {
+	segment, subpath, err := nextSegment(path, s.pathSep)
+	if err != nil {
+		return key, "", false
+	}
+	v, err := s.parseMapKey(fd, segment)
+	if err != nil {
+		return key, "", false
+	}
+	key = protoreflect.ValueOf(v).MapKey()
+	if !m.Has(key) || !s.allowKey(fd, key) {
+		return key, "", false
+	}
+	return key, subpath, true
+}
+
+// replaceMapValue returns a value indicating if the message value of the map field
+// with the given key is replaced, rather than merged, on an update.
+func (s *settings) replaceMapValue(fd protoreflect.FieldDescriptor, key protoreflect.MapKey, keyed bool) bool {
+	u, ok := s.mapValueUpdates[fd]
+	if !ok {
+		return false
+	}
+	if mode, ok := u.keys[key.Interface()]; ok {
+		return mode == ReplaceMapValues
+	}
+	return !keyed && u.wild == ReplaceMapValues
+}
+
+// unknownFieldError returns an error for an unknown field name in the message.
+// It notes if the name is reserved or if it's a field number without the "#" prefix.
+// In strict mode, it includes a hint if the name matches the other form of a field name.
+func (s *settings) unknownFieldError(desc protoreflect.MessageDescriptor, name string) error {
+	if desc.ReservedNames().Has(protoreflect.Name(name)) {
+		return fmt.Errorf("%v field %q is reserved", desc.FullName(), name)
+	}
+	if num, ok := parseFieldNumber(name); ok {
+		if desc.ReservedRanges().Has(num) {
+			return fmt.Errorf("%v field number %d is reserved", desc.FullName(), num)
+		}
+		return fmt.Errorf("unknown %v field number: %d", desc.FullName(), num)
+	}
+	if _, ok := parseFieldNumber("#" + name); ok {
+		// A bare number names neither a field nor, in a message, a map key.
+		return fmt.Errorf("unknown %v field: %q; a field number must be prefixed with \"#\", as in %q", desc.FullName(), name, "#"+name)
+	}
+	if s.strictNames {
+		fields := desc.Fields()
+		switch s.fieldName {
+		case TextFieldName:
+			if fd := fields.ByJSONName(name); fd != nil {
+				return fmt.Errorf("unknown %v text field: %q; did you mean %q or enable JSON field names?", desc.FullName(), name, fd.TextName())
+			}
+		case JSONFieldName:
+			if fd := fields.ByTextName(name); fd != nil {
+				return fmt.Errorf("unknown %v JSON field: %q; did you mean %q or enable text field names?", desc.FullName(), name, fd.JSONName())
+			}
+		}
+	}
+	return fmt.Errorf("unknown %v field: %q", desc.FullName(), name)
+}
+
+// parseState is the state of the path being added to a mask.
+type parseState struct {
+	trusted       bool                                 // the paths were validated when added to another mask
+	descs         []protoreflect.FullName              // message types along the path
+	fieldDepths   map[protoreflect.FieldDescriptor]int // occurrences of bounded fields along the path
+	mapValueDepth int                                  // number of map values along the path
+}
+
+// withParseState returns a copy of the settings with its own parse state,
+// so that adding paths with the copy doesn't write to state shared with the original.
+func (s settings) withParseState() settings {
+	s.state = &parseState{}
+	return s
+}
+
+// checkDeprecated returns an error if the field is deprecated and deprecated fields are rejected.
+func (s *settings) checkDeprecated(fd protoreflect.FieldDescriptor) error {
+	if s.warnDeprecated == nil && !s.rejectDeprecated || s.state.trusted {
+		return nil
+	}
+	if opts, ok := fd.Options().(*descriptorpb.FieldOptions); !ok || !opts.GetDeprecated() {
+		return nil
+	}
+	if s.warnDeprecated != nil {
+		s.warnDeprecated(fd)
+	}
+	if s.rejectDeprecated {
+		return fmt.Errorf("deprecated field: %v", fd.FullName())
+	}
+	return nil
+}
+
+// checkMapValueField returns an error if the path being added is within a map value
+// and the field is rejected by the field filter.
+func (s *settings) checkMapValueField(fd protoreflect.FieldDescriptor) error {
+	if s.state.mapValueDepth == 0 || s.fieldFilter == nil || s.fieldFilter(fd) {
+		return nil
+	}
+	return fmt.Errorf("filtered map value field: %v", fd.FullName())
+}
+
+// enterField records that a path is descending into the field.
+// It returns an error if the field is bounded and already occurs along the path its max depth times.
+func (s *settings) enterField(fd protoreflect.FieldDescriptor) error {
+	limit, ok := s.fieldMaxDepths[fd]
+	if !ok {
+		return nil
+	}
+	if s.state.fieldDepths[fd] >= limit && !s.state.trusted {
+		return fmt.Errorf("path exceeds max depth of field %v: %d", fd.FullName(), limit)
+	}
+	if s.state.fieldDepths == nil {
+		s.state.fieldDepths = make(map[protoreflect.FieldDescriptor]int)
+	}
+	s.state.fieldDepths[fd]++
+	return nil
+}
+
+// leaveField records that a path is done descending into the field.
+func (s *settings) leaveField(fd protoreflect.FieldDescriptor) {
+	if _, ok := s.fieldMaxDepths[fd]; ok {
+		s.state.fieldDepths[fd]--
+	}
+}
+
+// enterMessage records that a path is descending into the fields of a message of the given type.
+// It returns an error if recursion is rejected and the type is already along the path.
+func (s *settings) enterMessage(desc protoreflect.MessageDescriptor) error {
+	if !s.rejectRecursion {
+		return nil
+	}
+	if slices.Contains(s.state.descs, desc.FullName()) && !s.state.trusted {
+		return fmt.Errorf("recursive path: %v is already along the path", desc.FullName())
+	}
+	s.state.descs = append(s.state.descs, desc.FullName())
+	return nil
+}
+
+// leaveMessage records that a path is done descending into the fields of the last entered message.
+func (s *settings) leaveMessage() {
+	if s.rejectRecursion {
+		s.state.descs = s.state.descs[:len(s.state.descs)-1]
+	}
+}
+
+// lookupMessageField returns the key and descriptor of the field of the message with the given name.
+// The name may be "#" followed by the field's number, such as "#3", but the key is always the field's
+// name, so paths are output with names. If extensions are allowed, the name may also be the full name
+// of an extension of the message, which is resolved by the extension resolver.
+func (s *settings) lookupMessageField(desc protoreflect.MessageDescriptor, fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
+	if num, ok := parseFieldNumber(name); ok {
+		if fd := fields.ByNumber(num); fd != nil {
+			return s.fieldKey(fd), fd, true
+		}
+		return "", nil, false
+	}
+	if key, fd, ok := s.lookupField(fields, name); ok || !s.extensions {
+		return key, fd, ok
+	}
+	if strings.HasPrefix(name, "`") {
+		var err error
+		if name, err = quote.Unquote(name, '`'); err != nil {
+			return "", nil, false
+		}
+	}
+	resolver := s.extResolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalTypes
+	}
+	xt, err := resolver.FindExtensionByName(protoreflect.FullName(name))
+	if err != nil {
+		return "", nil, false
+	}
+	xd := xt.TypeDescriptor()
+	if xd.ContainingMessage().FullName() != desc.FullName() {
+		return "", nil, false
+	}
+	return s.fieldKey(xd), xd, true
+}
+
+// repeatedPrefixes returns the normalized path of each list and map field along the path from the root,
+// paired with the subpath that follows it. The keys of dynamic structures aren't descended into.
+func (s *settings) repeatedPrefixes(path string) ([][2]string, error) {
+	var (
+		prefixes [][2]string
+		prefix   string
+	)
+	join := func(segment string) {
+		if prefix == "" {
+			prefix = segment
+		} else {
+			prefix = joinFieldPath(prefix, segment, s.pathSep)
+		}
+	}
+	desc := s.rootDesc
+	for path != "" && path != "*" && structFieldName(desc) == "" {
+		name, subpath, err := nextSegment(path, s.pathSep)
+		if err != nil {
+			return nil, err
+		}
+		name, subpath, _ = splitListSlice(name, subpath, s.pathSep)
+		if typeName, ok := anyTypeName(name); ok && desc.FullName() == anyFullName {
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(typeName)
+			if err != nil {
+				return nil, fmt.Errorf("unknown %v packed type: %q", desc.FullName(), typeName)
+			}
+			join(name)
+			desc, path = mt.Descriptor(), subpath
+			continue
+		}
+		key, fd, ok := s.lookupMessageField(desc, desc.Fields(), name)
+		if !ok {
+			return nil, s.unknownFieldError(desc, name)
+		}
+		join(key)
+		if fd.IsList() || fd.IsMap() {
+			prefixes = append(prefixes, [2]string{prefix, subpath})
+			if subpath == "" || subpath == "*" {
+				break
+			}
+			key, rest, err := nextSegment(subpath, s.pathSep)
+			if err != nil {
+				return nil, err
+			}
+			if fd.IsMap() && strings.HasPrefix(key, "`") {
+				if key, err = quote.Unquote(key, '`'); err != nil {
+					return nil, err
+				}
+				key = s.quoteKey(key)
+			}
+			join(key)
+			subpath = rest
+		}
+		if subpath == "" {
+			break
+		}
+		if fd.IsMap() {
+			desc = fd.MapValue().Message()
+		} else {
+			desc = fd.Message()
+		}
+		if desc == nil {
+			return nil, fmt.Errorf("invalid scalar field subpath: %q", subpath)
+		}
+		path = subpath
+	}
+	return prefixes, nil
+}
+
+// lookupFieldPath returns the descriptor of the field at the given path from the root.
+// Every field in the path, except for the last, must be a singular message field.
+func (s *settings) lookupFieldPath(path string) (protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, fmt.Errorf("invalid field path: %q", path)
+	}
+	desc := s.rootDesc
+	for {
+		name, subpath, err := nextSegment(path, s.pathSep)
+		if err != nil {
+			return nil, err
+		}
+		_, fd, ok := s.lookupField(desc.Fields(), name)
+		if !ok {
+			return nil, s.unknownFieldError(desc, name)
+		}
+		if subpath == "" {
+			return fd, nil
+		}
+		if fd.IsList() || fd.IsMap() || fd.Message() == nil {
+			return nil, fmt.Errorf("invalid %v field: %q is not a singular message", desc.FullName(), name)
+		}
+		desc, path = fd.Message(), subpath
+	}
+}
+
+// compatible returns an error if the settings differ from the other settings in a way
+// that would make a mask combining paths from both of them ambiguous. Settings that are
+// functions can't be compared and are ignored.
+func (s *settings) compatible(other *settings) error {
+	var diff string
+	switch {
+	case s.rootDesc.FullName() != other.rootDesc.FullName():
+		diff = "message type"
+	case s.fieldName != other.fieldName || s.strictNames != other.strictNames:
+		diff = "field names"
+	case s.pathSep != other.pathSep:
+		diff = "path separator"
+	case !maps.Equal(s.aliases, other.aliases):
+		diff = "aliases"
+	case s.extensions != other.extensions:
+		diff = "extensions"
+	case s.globExpansion != other.globExpansion:
+		diff = "glob expansion"
+	case s.boolKeyStyle != other.boolKeyStyle || s.keyEncoding != other.keyEncoding ||
+		!sameFields(s.mapKeyTransforms, other.mapKeyTransforms):
+		diff = "map keys"
+	case s.keyedOverridesWild != other.keyedOverridesWild:
+		diff = "keyed map paths"
+	case !slices.Equal(s.alwaysInclude, other.alwaysInclude):
+		diff = "always-included paths"
+	case s.maskUnknowns != other.maskUnknowns ||
+		!maps.Equal(s.retainUnknowns, other.retainUnknowns) ||
+		!maps.Equal(s.dropUnknowns, other.dropUnknowns):
+		diff = "unknown fields"
+	case s.cloneDedupRepeated != other.cloneDedupRepeated ||
+		s.deterministicOrder != other.deterministicOrder ||
+		s.omitEmptyContainers != other.omitEmptyContainers ||
+		s.dropEmptyMapValues != other.dropEmptyMapValues ||
+		s.dropEmptyMessages != other.dropEmptyMessages ||
+		s.sanitizeFloats != other.sanitizeFloats ||
+		!maps.Equal(s.distinctBy, other.distinctBy):
+		diff = "mask modes"
+	case !maps.EqualFunc(s.enumFilters, other.enumFilters, maps.Equal[map[protoreflect.EnumNumber]bool]):
+		diff = "enum value filters"
+	case !maps.Equal(s.fieldMaxDepths, other.fieldMaxDepths):
+		diff = "field max depths"
+	case s.updateSettings != other.updateSettings ||
+		s.requirePresent != other.requirePresent ||
+		s.mapDeletePrefix != other.mapDeletePrefix:
+		diff = "update modes"
+	case !maps.EqualFunc(s.mapValueUpdates, other.mapValueUpdates, sameMapValueUpdate):
+		diff = "map value updates"
+	default:
+		return nil
+	}
+	return fmt.Errorf("incompatible mask settings: mismatched %s", diff)
+}
+
+// sameFields returns a value indicating if the maps have the same field descriptor keys.
+func sameFields[V any](a, b map[protoreflect.FieldDescriptor]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for fd := range a {
+		if _, ok := b[fd]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// sameMapValueUpdate returns a value indicating if the map value updates have the same modes.
+func sameMapValueUpdate(a, b *mapValueUpdate) bool {
+	return a.wild == b.wild && maps.Equal(a.keys, b.keys)
+}
+
+// allowKey returns a value indicating if the key of the map field passes any key filter.
+func (s *settings) allowKey(fd protoreflect.FieldDescriptor, key protoreflect.MapKey) bool {
+	filter, ok := s.mapKeyFilters[fd]
+	return !ok || filter(key)
+}
+
+// enumValue returns the descriptor of the enum values of the field, or nil if its values aren't enums.
+// The values of a map field are its map values and the values of a list field are its elements.
+func enumValue(fd protoreflect.FieldDescriptor) protoreflect.EnumDescriptor {
+	if fd.IsMap() {
+		return fd.MapValue().Enum()
+	}
+	return fd.Enum()
+}
+
+// allowScalar returns a value indicating if the scalar value of the field passes any enum value filter
+// and float sanitization. The value is a singular value, a list element, or a map value of the field.
+func (s *settings) allowScalar(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+	if s.sanitizeFloats && isFloat(fd) {
+		if f := val.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return false
+		}
+	}
+	allowed, ok := s.enumFilters[fd]
+	return !ok || allowed[val.Enum()]
+}
+
+// filtersScalars returns a value indicating if any scalar values of the field may be rejected by allowScalar.
+func (s *settings) filtersScalars(fd protoreflect.FieldDescriptor) bool {
+	if _, ok := s.enumFilters[fd]; ok {
+		return true
+	}
+	return s.sanitizeFloats && isFloat(fd)
+}
+
+// isFloat returns a value indicating if the scalar values of the field are floats or doubles.
+func isFloat(fd protoreflect.FieldDescriptor) bool {
+	kind := fd.Kind()
+	if fd.IsMap() {
+		kind = fd.MapValue().Kind()
+	}
+	return kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
+}
+
+// allowValue returns a value indicating if the value of a singular field passes any enum value filter
+// and float sanitization.
+func (s *settings) allowValue(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+	return fd.IsList() || fd.IsMap() || s.allowScalar(fd, val)
+}
+
+// filterScalarList removes any elements from the list that are rejected by allowScalar.
+func (s *settings) filterScalarList(list protoreflect.List, fd protoreflect.FieldDescriptor) {
+	if !s.filtersScalars(fd) {
+		return
+	}
+	n := 0
+	for i, l := 0, list.Len(); i < l; i++ {
+		if val := list.Get(i); s.allowScalar(fd, val) {
+			list.Set(n, val)
+			n++
+		}
+	}
+	list.Truncate(n)
+}
+
+// filterScalarMap clears any entries from the map whose values are rejected by allowScalar.
+func (s *settings) filterScalarMap(m protoreflect.Map, fd protoreflect.FieldDescriptor, path string) {
+	if !s.filtersScalars(fd) {
+		return
+	}
+	m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		if !s.allowScalar(fd, val) {
+			m.Clear(key)
+			s.clearedKey(path, fd, key)
+		}
+		return true
+	})
+}
+
+// filterMapKeys clears any entries from the map whose keys are rejected by the key filter for the field.
+func (s *settings) filterMapKeys(m protoreflect.Map, fd protoreflect.FieldDescriptor, path string) {
+	filter, ok := s.mapKeyFilters[fd]
+	if !ok {
+		return
+	}
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		if !filter(key) {
+			m.Clear(key)
+			s.clearedKey(path, fd, key)
+		}
+		return true
+	})
+}
+
+// checkMapKeys returns an error if adding a key to the given number of keys
+// selected for the map field would exceed the limit.
+func (s *settings) checkMapKeys(fd protoreflect.FieldDescriptor, n int) error {
+	if s.maxMapKeys > 0 && n >= s.maxMapKeys && !s.state.trusted {
+		return fmt.Errorf("too many keys for map field %v: limit is %d", fd.FullName(), s.maxMapKeys)
+	}
+	return nil
+}
+
+// formatMapKey returns the key of the map field formatted as a path segment, without quoting.
+func (s *settings) formatMapKey(fd protoreflect.FieldDescriptor, key protoreflect.MapKey) string {
+	if fd.MapKey().Kind() == protoreflect.StringKind {
+		fn := s.stringKeyFuncs(fd)
+		return fn.format(key.String())
+	}
+	return key.String()
+}
+
+// quoteKey returns the formatted map key as a path segment, quoting it if necessary.
+// With space-separated paths, keys containing whitespace are quoted too.
+func (s *settings) quoteKey(segment string) string {
+	if s.spaceSeparated && strings.IndexFunc(segment, unicode.IsSpace) != -1 {
+		return quote.With(segment, '`')
+	}
+	return maybeQuote(segment, s.pathSep)
+}
+
+// stringKeyFuncs returns the key functions of the string-keyed map field.
+func (s *settings) stringKeyFuncs(fd protoreflect.FieldDescriptor) keyFuncs[string] {
+	fn := stringKeyFuncs
+	if s.keyEncoding == PercentEncoding {
+		fn = percentStringKeyFuncs
+	}
+	t, ok := s.mapKeyTransforms[fd]
+	if !ok {
+		return fn
+	}
+	parse, format := fn.parse, fn.format
+	fn.parse = func(v string) (string, error) {
+		v, err := parse(v)
+		if err != nil {
+			return "", err
+		}
+		key, err := t.toKey(v)
+		if err != nil {
+			return "", fmt.Errorf("invalid %v key: %q: %w", fd.FullName(), v, err)
+		}
+		return key, nil
+	}
+	fn.format = func(v string) string { return format(t.fromKey(v)) }
+	return fn
+}
+
+func (s *settings) boolKeyFuncs() keyFuncs[byte] {
+	if s.boolKeyStyle == NumericBoolKeys {
+		return numericBoolKeyFuncs
+	}
+	return boolKeyFuncs
+}
+
+func (s *settings) allow(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsExtension() && !s.extensions {
+		return false
+	}
+	return s.fieldFilter == nil || s.fieldFilter(fd)
+}
+
+// populatedPaths returns the paths of the fields populated in the message.
+func (s *settings) populatedPaths(msg protoreflect.Message) []string {
+	var paths []string
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		if fd.IsExtension() || !s.allow(fd) {
+			return true // extensions are left out and disallowed fields aren't covered
+		}
+		name := s.fieldKey(fd)
+		switch {
+		case fd.IsMap():
+			isMsg := isMessage(fd.MapValue().Kind())
+			val.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				path := joinPath(name, s.quoteKey(s.formatMapKey(fd, key)), s.pathSep)
+				var subs []string
+				if isMsg {
+					subs = s.populatedPaths(val.Message())
+				}
+				paths = appendSubpaths(paths, path, subs, s.pathSep)
+				return true
+			})
+		case fd.IsList() || fd.Message() == nil:
+			paths = append(paths, name)
+		default:
+			paths = appendSubpaths(paths, name, s.populatedPaths(val.Message()), s.pathSep)
+		}
+		return true
+	})
+	return paths
+}
+
+// kindPaths returns the paths of the fields of the message with matching kinds.
+// It doesn't recurse into the message types that are along the path.
+func (s *settings) kindPaths(desc protoreflect.MessageDescriptor, kinds map[protoreflect.Kind]bool, pathDescs []protoreflect.FullName) []string {
+	var paths []string
+	fds := desc.Fields()
+	for i, n := 0, fds.Len(); i < n; i++ {
+		fd := fds.Get(i)
+		if !s.allow(fd) {
+			continue
+		}
+		kind, elem := fd.Kind(), fd.Message()
+		if fd.IsMap() {
+			kind, elem = fd.MapValue().Kind(), fd.MapValue().Message()
+		}
+		name := s.fieldKey(fd)
+		if kinds[kind] {
+			paths = append(paths, name)
+			continue
+		}
+		if elem == nil || structFieldName(elem) != "" || slices.Contains(pathDescs, elem.FullName()) {
+			continue
+		}
+		if fd.IsList() || fd.IsMap() {
+			name = joinPath(name, "*", s.pathSep)
+		}
+		for _, sub := range s.kindPaths(elem, kinds, append(pathDescs, elem.FullName())) {
+			paths = append(paths, joinPath(name, sub, s.pathSep))
+		}
+	}
+	return paths
+}
+
+func appendSubpaths(paths []string, path string, subs []string, sep rune) []string {
+	if len(subs) == 0 {
+		return append(paths, path)
+	}
+	for _, sub := range subs {
+		paths = append(paths, joinPath(path, sub, sep))
+	}
+	return paths
+}
+
+// diffPaths returns the paths of the fields that differ between the messages, which must be of the same type.
+// It recurses into message fields and the message values of maps, and it covers the differing keys of
+// maps and the entirety of differing lists. A message field that's present in only one message is covered
+// as a whole, unless presence is ignored, as is a message field whose only differences are unknown fields.
+func (s *settings) diffPaths(a, b protoreflect.Message) []string {
+	var paths []string
+	fds := a.Descriptor().Fields()
+	for i, n := 0, fds.Len(); i < n; i++ {
+		fd := fds.Get(i)
+		if !s.allow(fd) || (!a.Has(fd) && !b.Has(fd)) {
+			continue
+		}
+		name := s.fieldKey(fd)
+		switch {
+		case fd.IsMap():
+			paths = s.appendMapDiffPaths(paths, name, fd, a.Get(fd).Map(), b.Get(fd).Map())
+		case a.Has(fd) != b.Has(fd) && s.diffPresence == DiffComparesPresence:
+			paths = append(paths, name)
+		case fd.IsList() || fd.Message() == nil:
+			if !a.Get(fd).Equal(b.Get(fd)) {
+				paths = append(paths, name)
+			}
+		default:
+			paths = s.appendMessageDiffPaths(paths, name, a.Get(fd).Message(), b.Get(fd).Message())
+		}
+	}
+	return paths
+}
+
+func (s *settings) appendMessageDiffPaths(paths []string, path string, a, b protoreflect.Message) []string {
+	subs := s.diffPaths(a, b)
+	if len(subs) == 0 {
+		if !bytes.Equal(a.GetUnknown(), b.GetUnknown()) {
+			paths = append(paths, path)
+		}
+		return paths
+	}
+	return appendSubpaths(paths, path, subs, s.pathSep)
+}
 
-func lookupTextField(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
-	fd = fields.ByTextName(name)
-	if fd == nil {
-		fd = fields.ByJSONName(name)
+func (s *settings) appendMapDiffPaths(paths []string, name string, fd protoreflect.FieldDescriptor, a, b protoreflect.Map) []string {
+	isMsg := isMessage(fd.MapValue().Kind())
+	a.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		path := joinPath(name, s.quoteKey(s.formatMapKey(fd, key)), s.pathSep)
+		switch {
+		case !b.Has(key):
+			paths = append(paths, path)
+		case isMsg:
+			paths = s.appendMessageDiffPaths(paths, path, val.Message(), b.Get(key).Message())
+		case !val.Equal(b.Get(key)):
+			paths = append(paths, path)
+		}
+		return true
+	})
+	b.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		if !a.Has(key) {
+			paths = append(paths, joinPath(name, s.quoteKey(s.formatMapKey(fd, key)), s.pathSep))
+		}
+		return true
+	})
+	return paths
+}
+
+// filtering returns a value indicating if any field, map key, or unknown field filters are set,
+// or if floats are sanitized.
+func (s *settings) filtering() bool {
+	return s.fieldFilter != nil || s.mapKeyFilters != nil || s.enumFilters != nil || s.dropUnknowns != nil || s.sanitizeFloats || s.distinctBy != nil
+}
+
+// filterMessage clears any fields rejected by the field filter, any map entries
+// rejected by map key filters, and any unknown fields that must be dropped from the message.
+func (s *settings) filterMessage(msg protoreflect.Message, path string, c *callState) {
+	if !s.filtering() {
+		return
 	}
-	if fd == nil {
-		return "", nil, false
+	if s.dropUnknowns[msg.Descriptor().FullName()] {
+		msg.SetUnknown(nil)
 	}
-	return fd.TextName(), fd, true
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		c.check()
+		switch {
+		case !s.allow(fd), !s.allowValue(fd, val):
+			msg.Clear(fd)
+			s.cleared(path, s.fieldKey(fd), fd)
+		case fd.IsList():
+			s.filterList(val.List(), fd, s.clearPath(path, s.fieldKey(fd)), c)
+		case fd.IsMap():
+			s.filterMap(val.Map(), fd, s.clearPath(path, s.fieldKey(fd)), c)
+		case fd.Message() != nil:
+			s.filterMessage(val.Message(), s.clearPath(path, s.fieldKey(fd)), c)
+		}
+		return true
+	})
 }
 
-func lookupTextFieldStrict(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
-	fd = fields.ByTextName(name)
-	if fd == nil || fd.TextName() != name {
-		return "", nil, false
+func (s *settings) filterList(list protoreflect.List, fd protoreflect.FieldDescriptor, path string, c *callState) {
+	if !s.filtering() {
+		return
+	}
+	s.filterScalarList(list, fd)
+	if fd.Message() == nil {
+		return
+	}
+	s.distinctList(list, fd, path)
+	for i, n := 0, list.Len(); i < n; i++ {
+		s.filterMessage(list.Get(i).Message(), s.listIndexPath(path, i), c)
 	}
-	return fd.TextName(), fd, true
 }
 
-func lookupJSONField(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
-	fd = fields.ByJSONName(name)
-	if fd == nil {
-		fd = fields.ByTextName(name)
+func (s *settings) filterMap(m protoreflect.Map, fd protoreflect.FieldDescriptor, path string, c *callState) {
+	if !s.filtering() {
+		return
 	}
-	if fd == nil {
-		return "", nil, false
+	s.filterMapKeys(m, fd, path)
+	s.filterScalarMap(m, fd, path)
+	if fd.MapValue().Message() == nil {
+		return
 	}
-	return fd.JSONName(), fd, true
+	m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		s.filterMessage(val.Message(), s.mapKeyPath(path, fd, key), c)
+		return true
+	})
 }
 
-func lookupJSONFieldStrict(fields protoreflect.FieldDescriptors, name string) (key string, fd protoreflect.FieldDescriptor, found bool) {
-	fd = fields.ByJSONName(name)
-	if fd == nil || fd.JSONName() != name {
-		return "", nil, false
+// filtersMessage returns a value indicating if filterMessage would change the message.
+func (s *settings) filtersMessage(msg protoreflect.Message) bool {
+	if !s.filtering() {
+		return false
 	}
-	return fd.JSONName(), fd, true
+	if s.dropUnknowns[msg.Descriptor().FullName()] && len(msg.GetUnknown()) > 0 {
+		return true
+	}
+	modified := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		switch {
+		case !s.allow(fd), !s.allowValue(fd, val):
+			modified = true
+		case fd.IsList():
+			modified = s.filtersList(val.List(), fd)
+		case fd.IsMap():
+			modified = s.filtersMap(val.Map(), fd)
+		case fd.Message() != nil:
+			modified = s.filtersMessage(val.Message())
+		}
+		return !modified
+	})
+	return modified
 }
 
-type settings struct {
-	rootDesc   protoreflect.MessageDescriptor
-	extensions bool
+// filtersList returns a value indicating if filterList would change the list.
+func (s *settings) filtersList(list protoreflect.List, fd protoreflect.FieldDescriptor) bool {
+	if !s.filtering() {
+		return false
+	}
+	if s.filtersScalarList(list, fd) {
+		return true
+	}
+	if fd.Message() == nil {
+		return false
+	}
+	d := s.distinctSet(fd)
+	for i, n := 0, list.Len(); i < n; i++ {
+		if msg := list.Get(i).Message(); !d.first(msg) || s.filtersMessage(msg) {
+			return true
+		}
+	}
+	return false
+}
 
-	lookupField    fieldLookupFunc
-	maskUnknowns   MaskUnknowns
-	updateUnknowns UpdateUnknowns
-	updateRepeated UpdateRepeated
+// filtersScalarList returns a value indicating if filterScalarList would change the list.
+func (s *settings) filtersScalarList(list protoreflect.List, fd protoreflect.FieldDescriptor) bool {
+	if !s.filtersScalars(fd) {
+		return false
+	}
+	for i, n := 0, list.Len(); i < n; i++ {
+		if !s.allowScalar(fd, list.Get(i)) {
+			return true
+		}
+	}
+	return false
 }
 
-func (s *settings) allow(fd protoreflect.FieldDescriptor) bool {
-	return !(fd.IsExtension() && !s.extensions)
+// filtersMap returns a value indicating if filterMap would change the map.
+func (s *settings) filtersMap(m protoreflect.Map, fd protoreflect.FieldDescriptor) bool {
+	if !s.filtering() {
+		return false
+	}
+	modified := false
+	m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		modified = s.filtersMapEntry(fd, key, val)
+		return !modified
+	})
+	return modified
+}
+
+// filtersMapEntry returns a value indicating if filterMap would change the map entry.
+func (s *settings) filtersMapEntry(fd protoreflect.FieldDescriptor, key protoreflect.MapKey, val protoreflect.Value) bool {
+	if !s.filtering() {
+		return false
+	}
+	if !s.allowKey(fd, key) || !s.allowScalar(fd, val) {
+		return true
+	}
+	return fd.MapValue().Message() != nil && s.filtersMessage(val.Message())
+}
+
+// clearPath returns the path joined with the segment, if there's an OnClear callback.
+// Otherwise, it returns an empty string to avoid building paths that won't be used.
+func (s *settings) clearPath(path, segment string) string {
+	if s.onClear == nil {
+		return ""
+	}
+	if path == "" {
+		return segment
+	}
+	return joinPath(path, segment, s.pathSep)
+}
+
+// mapKeyPath returns the path of the map field's entry with the given key, if there's an OnClear callback.
+func (s *settings) mapKeyPath(path string, fd protoreflect.FieldDescriptor, key protoreflect.MapKey) string {
+	if s.onClear == nil {
+		return ""
+	}
+	return s.clearPath(path, s.quoteKey(s.formatMapKey(fd, key)))
+}
+
+// listIndexPath returns the path of the list element with the given index, if there's an OnClear callback.
+func (s *settings) listIndexPath(path string, i int) string {
+	if s.onClear == nil {
+		return ""
+	}
+	return s.clearPath(path, strconv.Itoa(i))
+}
+
+// cleared invokes the OnClear callback, if any, for the field that was cleared from the message at the path.
+func (s *settings) cleared(path, key string, fd protoreflect.FieldDescriptor) {
+	if s.onClear != nil {
+		s.onClear(s.clearPath(path, key), fd)
+	}
+}
+
+// clearedKey invokes the OnClear callback, if any, for the entry that was cleared from the map field at the path.
+func (s *settings) clearedKey(path string, fd protoreflect.FieldDescriptor, key protoreflect.MapKey) {
+	if s.onClear != nil {
+		s.onClear(s.mapKeyPath(path, fd, key), fd)
+	}
 }
 
-func (s *settings) copyMessage(dst, src protoreflect.Message) {
-	src.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+func (s *settings) copyMessage(dst, src protoreflect.Message, c *callState) {
+	s.rangeFields(src, func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		c.check()
 		switch {
-		case !s.allow(fd):
+		case !s.allow(fd), !s.allowValue(fd, val):
 			// no-op
 		case fd.IsList():
-			s.copyList(dst.Mutable(fd).List(), val.List(), fd)
+			s.copyList(dst.Mutable(fd).List(), val.List(), fd, c)
+			if s.omitEmpty(fd, dst.Get(fd)) {
+				dst.Clear(fd)
+			}
 		case fd.IsMap():
-			s.copyMap(dst.Mutable(fd).Map(), val.Map(), fd)
+			s.copyMap(dst.Mutable(fd).Map(), val.Map(), fd, c)
+			if s.omitEmpty(fd, dst.Get(fd)) {
+				dst.Clear(fd)
+			}
 		case fd.Message() != nil:
-			s.copyMessage(dst.Mutable(fd).Message(), val.Message())
-		case fd.Kind() == protoreflect.BytesKind:
-			dst.Set(fd, cloneBytesValue(val))
+			s.copyMessage(dst.Mutable(fd).Message(), val.Message(), c)
 		default:
-			dst.Set(fd, val)
+			dst.Set(fd, s.cloneScalar(fd, val, c))
+		}
+		return true
+	})
+	if raw := s.maskedUnknowns(src.Descriptor(), src.GetUnknown()); len(raw) > 0 {
+		dst.SetUnknown(raw)
+	}
+}
+
+// rangeFields calls f for each populated field of the message, like Range, but in
+// field number order if the deterministic field order is set.
+func (s *settings) rangeFields(msg protoreflect.Message, f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if !s.deterministicOrder {
+		msg.Range(f)
+		return
+	}
+	var fds []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	slices.SortFunc(fds, func(a, b protoreflect.FieldDescriptor) int {
+		return cmp.Compare(a.Number(), b.Number())
+	})
+	for _, fd := range fds {
+		if !f(fd, msg.Get(fd)) {
+			return
+		}
+	}
+}
+
+// omitEmpty returns a value indicating if the cloned value of the field is omitted from the clone
+// because it's an empty list or map.
+func (s *settings) omitEmpty(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+	switch {
+	case !s.omitEmptyContainers:
+		return false
+	case fd.IsList():
+		return val.List().Len() == 0
+	case fd.IsMap():
+		return val.Map().Len() == 0
+	default:
+		return false
+	}
+}
+
+// emptyMapValue returns a value indicating if the masked value of the map field is dropped
+// because it's an empty message.
+func (s *settings) emptyMapValue(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+	return s.dropEmptyMapValues && fd.MapValue().Message() != nil && proto.Size(val.Message().Interface()) == 0
+}
+
+// dropDefaultMessages clears any message fields, message list elements, and message map values of the
+// message that are empty after recursively doing the same to them, if default-valued messages are dropped.
+// A message field in a oneof is retained because it selects the oneof's case.
+func (s *settings) dropDefaultMessages(msg protoreflect.Message) {
+	if !s.dropEmptyMessages {
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list, n := val.List(), 0
+			for i, l := 0, list.Len(); i < l; i++ {
+				elem := list.Get(i)
+				s.dropDefaultMessages(elem.Message())
+				if proto.Size(elem.Message().Interface()) > 0 {
+					list.Set(n, elem)
+					n++
+				}
+			}
+			list.Truncate(n)
+			if n == 0 {
+				msg.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			m := val.Map()
+			m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				s.dropDefaultMessages(val.Message())
+				if proto.Size(val.Message().Interface()) == 0 {
+					m.Clear(key)
+				}
+				return true
+			})
+			if m.Len() == 0 {
+				msg.Clear(fd)
+			}
+		case fd.Message() != nil:
+			s.dropDefaultMessages(val.Message())
+			if fd.ContainingOneof() == nil && proto.Size(val.Message().Interface()) == 0 {
+				msg.Clear(fd)
+			}
+		}
+		return true
+	})
+}
+
+// dropEmptyValues clears any entries from the map of the field whose values are empty messages,
+// if they're dropped, and invokes the cleared function, if any, with their keys.
+func (s *settings) dropEmptyValues(m protoreflect.Map, fd protoreflect.FieldDescriptor, cleared func(protoreflect.MapKey)) {
+	if !s.dropEmptyMapValues || fd.MapValue().Message() == nil {
+		return
+	}
+	m.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+		if s.emptyMapValue(fd, val) {
+			m.Clear(key)
+			if cleared != nil {
+				cleared(key)
+			}
 		}
 		return true
 	})
+}
+
+// maskedUnknowns returns a copy of the unknown fields that are retained when a message of the given type is masked.
+func (s *settings) maskedUnknowns(desc protoreflect.MessageDescriptor, raw protoreflect.RawFields) protoreflect.RawFields {
+	if s.dropUnknowns[desc.FullName()] {
+		return nil
+	}
 	if s.maskUnknowns == MaskRetainsUnknowns {
-		dst.SetUnknown(copyBytes(src.GetUnknown()))
+		return copyBytes(raw)
+	}
+	if len(s.retainUnknowns) == 0 {
+		return nil
 	}
+	var out protoreflect.RawFields
+	for len(raw) > 0 {
+		num, _, n := protowire.ConsumeField(raw)
+		if n < 0 {
+			break // malformed
+		}
+		if s.retainUnknowns[num] {
+			out = append(out, raw[:n]...)
+		}
+		raw = raw[n:]
+	}
+	return out
 }
 
-func (s *settings) copyList(dst, src protoreflect.List, fd protoreflect.FieldDescriptor) {
+func (s *settings) copyList(dst, src protoreflect.List, fd protoreflect.FieldDescriptor, c *callState) {
 	switch {
 	case fd.Message() != nil:
+		d := s.distinctSet(fd)
 		for i, n := 0, src.Len(); i < n; i++ {
+			if !d.first(src.Get(i).Message()) {
+				continue
+			}
 			msg := dst.NewElement()
-			s.copyMessage(msg.Message(), src.Get(i).Message())
+			s.copyMessage(msg.Message(), src.Get(i).Message(), c)
 			dst.Append(msg)
 		}
-	case fd.Kind() == protoreflect.BytesKind:
-		for i, n := 0, src.Len(); i < n; i++ {
-			dst.Append(cloneBytesValue(src.Get(i)))
-		}
 	default:
 		for i, n := 0, src.Len(); i < n; i++ {
-			dst.Append(src.Get(i))
+			if val := src.Get(i); s.allowScalar(fd, val) {
+				dst.Append(s.cloneScalar(fd, val, c))
+			}
+		}
+	}
+	if s.cloneDedupRepeated {
+		dedupList(dst, fd)
+	}
+}
+
+// A distinctSet tracks the values of the distinct-by subfield of the elements of a message list.
+type distinctSet struct {
+	fd   protoreflect.FieldDescriptor
+	seen map[any]bool
+}
+
+// distinctSet returns an empty set for the message list field, or nil if it has no distinct-by subfield.
+func (s *settings) distinctSet(fd protoreflect.FieldDescriptor) *distinctSet {
+	sub, ok := s.distinctBy[fd]
+	if !ok {
+		return nil
+	}
+	return &distinctSet{fd: sub, seen: make(map[any]bool)}
+}
+
+// first returns a value indicating if the message is the first one added to the set with its value
+// of the subfield. A nil set reports that every message is first.
+func (d *distinctSet) first(msg protoreflect.Message) bool {
+	if d == nil {
+		return true
+	}
+	val := msg.Get(d.fd)
+	var key any = val.Interface()
+	if d.fd.Kind() == protoreflect.BytesKind {
+		key = string(val.Bytes())
+	}
+	if d.seen[key] {
+		return false
+	}
+	d.seen[key] = true
+	return true
+}
+
+// distinctList removes the elements of the message list that aren't the first with their value
+// of the list field's distinct-by subfield, if any, preserving the order of the others.
+func (s *settings) distinctList(list protoreflect.List, fd protoreflect.FieldDescriptor, path string) {
+	d := s.distinctSet(fd)
+	if d == nil {
+		return
+	}
+	drop := make([]bool, list.Len())
+	for i := range drop {
+		drop[i] = !d.first(list.Get(i).Message())
+	}
+	s.removeElements(list, fd, drop, path, 0)
+}
+
+// removeElements removes the elements of the list that are marked to be dropped, preserving the order
+// of the others. The OnClear callback, if any, is invoked with the index of each removed element plus
+// the offset, which is the index of the first element of the list in the original list.
+func (s *settings) removeElements(list protoreflect.List, fd protoreflect.FieldDescriptor, drop []bool, path string, offset int) {
+	n := 0
+	for i, l := 0, list.Len(); i < l; i++ {
+		if drop[i] {
+			s.cleared(path, strconv.Itoa(i+offset), fd)
+			continue
+		}
+		list.Set(n, list.Get(i))
+		n++
+	}
+	list.Truncate(n)
+}
+
+// dedupList removes any duplicate elements from the list, preserving the order of first occurrence.
+// Message elements are compared with proto.Equal.
+func dedupList(list protoreflect.List, fd protoreflect.FieldDescriptor) {
+	var (
+		seen map[any]bool
+		msgs []proto.Message
+		n    int
+	)
+	for i, l := 0, list.Len(); i < l; i++ {
+		val := list.Get(i)
+		switch {
+		case fd.Message() != nil:
+			msg := val.Message().Interface()
+			if slices.ContainsFunc(msgs, func(m proto.Message) bool { return proto.Equal(m, msg) }) {
+				continue
+			}
+			msgs = append(msgs, msg)
+		default:
+			var key any = val.Interface()
+			if fd.Kind() == protoreflect.BytesKind {
+				key = string(val.Bytes())
+			}
+			if seen[key] {
+				continue
+			}
+			if seen == nil {
+				seen = make(map[any]bool)
+			}
+			seen[key] = true
 		}
+		list.Set(n, val)
+		n++
 	}
+	list.Truncate(n)
 }
 
-func (s *settings) copyMap(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor) {
-	fd = fd.MapValue()
+func (s *settings) copyMap(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor, c *callState) {
+	vd := fd.MapValue()
 	switch {
-	case fd.Message() != nil:
-		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			msg := dst.NewValue()
-			s.copyMessage(msg.Message(), val.Message())
-			dst.Set(key, msg)
-			return true
-		})
-	case fd.Kind() == protoreflect.BytesKind:
+	case vd.Message() != nil:
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			dst.Set(key, cloneBytesValue(val))
+			if s.allowKey(fd, key) {
+				msg := dst.NewValue()
+				s.copyMessage(msg.Message(), val.Message(), c)
+				dst.Set(key, msg)
+			}
 			return true
 		})
 	default:
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-			dst.Set(key, val)
+			if s.allowKey(fd, key) && s.allowScalar(fd, val) {
+				dst.Set(key, s.cloneScalar(fd, val, c))
+			}
 			return true
 		})
 	}
 }
 
-func (s *settings) updateMessage(dst, src protoreflect.Message) {
+func (s *settings) updateMessage(dst, src protoreflect.Message, c *callState) {
 	fds := dst.Descriptor().Fields()
 	for i, n := 0, fds.Len(); i < n; i++ {
-		s.updateField(dst, src, fds.Get(i))
+		s.updateField(dst, src, fds.Get(i), c)
+	}
+	if s.extensions {
+		s.updateExtensions(dst, src, c)
+	}
+	s.doUpdateUnknowns(dst, src, c)
+}
+
+// updateExtensions updates the extension fields populated in either the destination or source message.
+func (s *settings) updateExtensions(dst, src protoreflect.Message, c *callState) {
+	var xds []protoreflect.FieldDescriptor
+	seen := make(map[protoreflect.FullName]bool)
+	collect := func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.IsExtension() && !seen[fd.FullName()] {
+			seen[fd.FullName()] = true
+			xds = append(xds, fd)
+		}
+		return true
+	}
+	dst.Range(collect)
+	if src.IsValid() {
+		src.Range(collect)
+	}
+	for _, xd := range xds {
+		s.updateField(dst, src, xd, c)
 	}
-	s.doUpdateUnknowns(dst, src)
 }
 
-func (s *settings) doUpdateUnknowns(dst, src protoreflect.Message) {
+func (s *settings) doUpdateUnknowns(dst, src protoreflect.Message, c *callState) {
 	var srcUnknowns protoreflect.RawFields
 	if src.IsValid() {
 		srcUnknowns = src.GetUnknown()
 	}
 	switch {
-	case s.updateUnknowns == UpdateAppendsUnknowns && len(srcUnknowns) > 0:
+	case c.updates(s).updateUnknowns == UpdateAppendsUnknowns && len(srcUnknowns) > 0:
 		dst.SetUnknown(append(copyBytes(dst.GetUnknown()), srcUnknowns...))
-	case s.updateUnknowns == UpdateReplacesUnknowns:
+	case c.updates(s).updateUnknowns == UpdateReplacesUnknowns:
 		dst.SetUnknown(copyBytes(srcUnknowns))
+	case c.updates(s).updateUnknowns == UpdateMergesUnknowns && len(srcUnknowns) > 0:
+		dst.SetUnknown(mergeUnknowns(dst.GetUnknown(), srcUnknowns))
+	}
+}
+
+// mergeUnknowns returns the source unknown fields merged into a copy of the destination unknown fields.
+// A field number that occurs more than once in either is repeated and its source entries are appended.
+// Any other field number is singular and its source entry replaces its destination entry.
+// If either is malformed, the source unknown fields are appended.
+func mergeUnknowns(dst, src protoreflect.RawFields) protoreflect.RawFields {
+	dstCounts, ok := countUnknowns(dst)
+	if !ok {
+		return append(copyBytes(dst), src...)
+	}
+	srcCounts, ok := countUnknowns(src)
+	if !ok {
+		return append(copyBytes(dst), src...)
+	}
+	out := make(protoreflect.RawFields, 0, len(dst)+len(src))
+	for b := dst; len(b) > 0; {
+		num, _, n := protowire.ConsumeField(b)
+		if srcCounts[num] == 0 || dstCounts[num] > 1 || srcCounts[num] > 1 {
+			out = append(out, b[:n]...) // absent from the source or repeated
+		}
+		b = b[n:]
+	}
+	return append(out, src...)
+}
+
+// countUnknowns returns the number of occurrences of each field number in the unknown fields
+// and a value indicating if they're well-formed.
+func countUnknowns(raw protoreflect.RawFields) (map[protoreflect.FieldNumber]int, bool) {
+	counts := make(map[protoreflect.FieldNumber]int)
+	for len(raw) > 0 {
+		num, _, n := protowire.ConsumeField(raw)
+		if n < 0 {
+			return nil, false
+		}
+		counts[num]++
+		raw = raw[n:]
 	}
+	return counts, true
 }
 
-func (s *settings) updateField(dst, src protoreflect.Message, fd protoreflect.FieldDescriptor) {
-	if !s.allow(fd) {
+func (s *settings) updateField(dst, src protoreflect.Message, fd protoreflect.FieldDescriptor, c *callState) {
+	c.check()
+	if !s.allow(fd) || !s.updatesField(fd, src.Get(fd), c) {
 		return // no-op
 	}
 	if !src.Has(fd) {
-		if fd.IsList() && s.updateRepeated == UpdateAppendsRepeated {
+		if fd.IsList() && c.updates(s).updateRepeated == UpdateAppendsRepeated {
 			return // no-op
 		}
 		dst.Clear(fd)
+		s.updatedField(fd, src.Get(fd), false, c)
 		return
 	}
+	defer s.updatedField(fd, src.Get(fd), true, c)
 	switch {
 	case fd.IsList():
-		s.updateList(dst.Mutable(fd).List(), src.Get(fd).List(), fd)
+		c.untrack()
+		s.updateList(dst.Mutable(fd).List(), src.Get(fd).List(), fd, c)
+		c.track()
 	case fd.IsMap():
-		s.updateMap(dst.Mutable(fd).Map(), src.Get(fd).Map(), fd)
+		c.untrack()
+		s.updateMap(dst.Mutable(fd).Map(), src.Get(fd).Map(), fd, c)
+		c.track()
 	case fd.Message() != nil:
-		s.updateMessage(dst.Mutable(fd).Message(), src.Get(fd).Message())
+		prev := c.pushPath(s, s.fieldKey(fd))
+		s.updateMessage(dst.Mutable(fd).Message(), src.Get(fd).Message(), c)
+		c.popPath(prev)
 	default:
 		if src.Has(fd) {
-			dst.Set(fd, src.Get(fd))
+			dst.Set(fd, s.mergeField(dst, fd, src.Get(fd)))
 		} else {
 			dst.Clear(fd)
 		}
 	}
 }
 
-func (s *settings) updateList(dst, src protoreflect.List, fd protoreflect.FieldDescriptor) {
-	if s.updateRepeated != UpdateAppendsRepeated {
+// updatedField invokes the OnUpdateField callback, if any, for the field of the message being
+// updated that was set or cleared with the source value, unless the update was a no-op.
+// A message field that's set isn't reported, because its updated fields are reported instead.
+func (s *settings) updatedField(fd protoreflect.FieldDescriptor, src protoreflect.Value, exists bool, c *callState) {
+	switch {
+	case s.onUpdateField == nil, c == nil, c.untracked > 0:
+		// Not reported
+	case exists && fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+		// Descended into
+	case !s.updatesField(fd, src, c):
+		// no-op
+	case !exists && fd.IsList() && c.updates(s).updateRepeated == UpdateAppendsRepeated:
+		// no-op
+	default:
+		s.onUpdateField(c.fieldPath(s, s.fieldKey(fd)), fd, !exists)
+	}
+}
+
+func (s *settings) updateList(dst, src protoreflect.List, fd protoreflect.FieldDescriptor, c *callState) {
+	appends := c.updates(s).updateRepeated == UpdateAppendsRepeated
+	if fd.Message() == nil && s.leafMerger != nil {
+		merged := s.mergeList(fd, dst, src, appends)
+		if !appends {
+			dst.Truncate(0)
+		}
+		for _, v := range merged {
+			dst.Append(v)
+		}
+		return
+	}
+	if !appends {
 		dst.Truncate(0)
 	}
 	if fd.Message() != nil {
 		for i, n := 0, src.Len(); i < n; i++ {
 			// TODO: This doesn't necessarily require a copy.
 			msg := dst.NewElement()
-			s.updateMessage(msg.Message(), src.Get(i).Message())
+			s.updateMessage(msg.Message(), src.Get(i).Message(), c)
 			dst.Append(msg)
 		}
 		return
@@ -195,29 +1756,153 @@ func (s *settings) updateList(dst, src protoreflect.List, fd protoreflect.FieldD
 	}
 }
 
-func (s *settings) updateMap(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor) {
+func (s *settings) updateMap(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor, c *callState) {
 	dst.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
 		if !src.Has(key) {
 			dst.Clear(key)
 		}
 		return true
 	})
+	defer s.applyTombstones(dst, src, fd, nil)
 	if fd.MapValue().Message() != nil {
 		src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+			if s.isTombstone(fd, key) {
+				return true
+			}
 			// TODO: This doesn't necessarily require a copy.
 			msg := dst.NewValue()
-			s.updateMessage(msg.Message(), val.Message())
+			s.updateMessage(msg.Message(), val.Message(), c)
 			dst.Set(key, msg)
 			return true
 		})
 		return
 	}
 	src.Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
-		dst.Set(key, val)
+		if !s.isTombstone(fd, key) {
+			dst.Set(key, s.mergeMapValue(fd, dst.Get(key), val))
+		}
+		return true
+	})
+}
+
+// isTombstone returns a value indicating if the key of the map field is a tombstone.
+func (s *settings) isTombstone(fd protoreflect.FieldDescriptor, key protoreflect.MapKey) bool {
+	return s.mapDeletePrefix != "" &&
+		fd.MapKey().Kind() == protoreflect.StringKind &&
+		strings.HasPrefix(key.String(), s.mapDeletePrefix)
+}
+
+// applyTombstones deletes the keys from the destination map that are marked by tombstones
+// in the source map, if they're covered. A nil covered function covers every key.
+func (s *settings) applyTombstones(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor, covered func(protoreflect.MapKey) bool) {
+	if s.mapDeletePrefix == "" || fd.MapKey().Kind() != protoreflect.StringKind {
+		return
+	}
+	src.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		if !s.isTombstone(fd, key) {
+			return true
+		}
+		deleted := protoreflect.ValueOfString(strings.TrimPrefix(key.String(), s.mapDeletePrefix)).MapKey()
+		if covered == nil || covered(deleted) {
+			dst.Clear(deleted)
+		}
 		return true
 	})
 }
 
+// clearEmptyMap clears the map field from the parent if it's empty and the settings require it.
+func (s *settings) clearEmptyMap(parent protoreflect.Message, fd protoreflect.FieldDescriptor, c *callState) {
+	if c.updates(s).updateClearsEmptyMaps && parent.Get(fd).Map().Len() == 0 {
+		parent.Clear(fd)
+	}
+}
+
+// clearEmptyList clears the list field from the parent if it's empty and the settings require it.
+func (s *settings) clearEmptyList(parent protoreflect.Message, fd protoreflect.FieldDescriptor, c *callState) {
+	if c.updates(s).updateClearsEmptyLists && parent.Get(fd).List().Len() == 0 {
+		parent.Clear(fd)
+	}
+}
+
+// updatesField returns a value indicating if the scalar field passes the update condition
+// with the source value. An invalid source value is replaced by the field's default value.
+// Fields that aren't scalars always pass.
+func (s *settings) updatesField(fd protoreflect.FieldDescriptor, src protoreflect.Value, c *callState) bool {
+	if fd.IsList() || fd.IsMap() || fd.Message() != nil {
+		return true
+	}
+	if !src.IsValid() {
+		src = fd.Default()
+	}
+	if c.updates(s).updateSkipsDefaults && !fd.HasPresence() && !isWrapper(fd.ContainingMessage()) && src.Equal(fd.Default()) {
+		return false // The wrapper message's presence is the field's presence.
+	}
+	return s.updateCondition == nil || s.updateCondition(fd, src)
+}
+
+// mergeField returns the value to set for the scalar field on the parent when it's updated with the source value.
+func (s *settings) mergeField(parent protoreflect.Message, fd protoreflect.FieldDescriptor, src protoreflect.Value) protoreflect.Value {
+	if s.leafMerger == nil {
+		return src
+	}
+	var dst protoreflect.Value
+	if parent.Has(fd) {
+		dst = parent.Get(fd)
+	}
+	return s.mergeLeaf(fd, dst, src)
+}
+
+// mergeMapValue returns the value to set for a key of the scalar map field when it's updated with the source value.
+// Bytes are copied so that the destination map doesn't share them with the source map.
+func (s *settings) mergeMapValue(fd protoreflect.FieldDescriptor, dst, src protoreflect.Value) protoreflect.Value {
+	v := s.mergeLeaf(fd.MapValue(), dst, src)
+	if fd.MapValue().Kind() == protoreflect.BytesKind {
+		v = cloneBytesValue(v)
+	}
+	return v
+}
+
+// mergeList returns the elements of the source scalar list merged with the elements of the
+// destination list at the same indices, or with invalid values if they're appended.
+func (s *settings) mergeList(fd protoreflect.FieldDescriptor, dst, src protoreflect.List, appended bool) []protoreflect.Value {
+	out := make([]protoreflect.Value, src.Len())
+	for i := range out {
+		var v protoreflect.Value
+		if !appended && i < dst.Len() {
+			v = dst.Get(i)
+		}
+		out[i] = s.mergeLeaf(fd, v, src.Get(i))
+	}
+	return out
+}
+
+// mergeLeaf returns the merged value of the destination and source scalar values.
+func (s *settings) mergeLeaf(fd protoreflect.FieldDescriptor, dst, src protoreflect.Value) protoreflect.Value {
+	if s.leafMerger == nil {
+		return src
+	}
+	if v := s.leafMerger(fd, dst, src); v.IsValid() {
+		return v
+	}
+	return src
+}
+
+// cloneScalar returns a copy of a scalar value of the field, which is a singular value, a list element,
+// or a map value, transformed by the clone transform, if any. Bytes are copied before they're transformed.
+func (s *settings) cloneScalar(fd protoreflect.FieldDescriptor, val protoreflect.Value, c *callState) protoreflect.Value {
+	kind := fd.Kind()
+	if fd.IsMap() {
+		kind = fd.MapValue().Kind()
+	}
+	if kind == protoreflect.BytesKind {
+		val = cloneBytesValue(val)
+	}
+	if c.transforms(s) {
+		val = s.cloneTransform(fd, val)
+	}
+	return val
+}
+
 func cloneBytesValue(val protoreflect.Value) protoreflect.Value {
 	return protoreflect.ValueOfBytes(copyBytes(val.Bytes()))
 }
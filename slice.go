@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// A list slice selects a contiguous range of the elements of a list field, such as the last
+// three with "repeated_field[-3:]". Like a slice expression, the start is inclusive, the end
+// is exclusive, either may be omitted, and a negative index counts back from the end.
+
+// A listSlice is a selector of a contiguous range of the elements of a list.
+type listSlice struct {
+	start, end       int
+	hasStart, hasEnd bool
+}
+
+// parseListSlice parses a list slice selector, such as "[-3:]".
+func parseListSlice(token string) (listSlice, bool) {
+	if len(token) < 3 || token[0] != '[' || token[len(token)-1] != ']' {
+		return listSlice{}, false
+	}
+	lo, hi, ok := strings.Cut(token[1:len(token)-1], ":")
+	if !ok {
+		return listSlice{}, false
+	}
+	var s listSlice
+	if s.start, s.hasStart, ok = parseSliceIndex(lo); !ok {
+		return listSlice{}, false
+	}
+	if s.end, s.hasEnd, ok = parseSliceIndex(hi); !ok {
+		return listSlice{}, false
+	}
+	return s, true
+}
+
+func parseSliceIndex(s string) (i int, present, ok bool) {
+	if s == "" {
+		return 0, false, true
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || strconv.Itoa(i) != s {
+		return 0, false, false
+	}
+	return i, true, true
+}
+
+func (s listSlice) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	if s.hasStart {
+		b.WriteString(strconv.Itoa(s.start))
+	}
+	b.WriteByte(':')
+	if s.hasEnd {
+		b.WriteString(strconv.Itoa(s.end))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// bounds returns the range of the elements selected from a list with the given length.
+// The bounds are clamped to the length, and a start beyond the end selects no elements.
+func (s listSlice) bounds(n int) (start, end int) {
+	start, end = 0, n
+	if s.hasStart {
+		start = clampSliceIndex(s.start, n)
+	}
+	if s.hasEnd {
+		end = clampSliceIndex(s.end, n)
+	}
+	return start, max(start, end)
+}
+
+func clampSliceIndex(i, n int) int {
+	if i < 0 {
+		i += n
+	}
+	return min(max(i, 0), n)
+}
+
+// splitListSlice moves a list slice selector from the end of the field name, if any,
+// to the beginning of the subpath. It returns a value indicating if a slice was moved.
+func splitListSlice(name, subpath string, sep rune) (string, string, bool) {
+	i := strings.IndexByte(name, '[')
+	if i <= 0 || name[len(name)-1] != ']' || name[0] == '`' {
+		return name, subpath, false
+	}
+	if subpath == "" {
+		return name[:i], name[i:], true
+	}
+	return name[:i], joinPath(name[i:], subpath, sep), true
+}
+
+// joinFieldPath joins the name of a field with its subpath.
+// A subpath that begins with a list slice is appended directly to the name.
+func joinFieldPath(name, subpath string, sep rune) string {
+	if strings.HasPrefix(subpath, "[") {
+		return name + subpath
+	}
+	return joinPath(name, subpath, sep)
+}
+
+// A subList is a read-only view of a range of a list.
+type subList struct {
+	protoreflect.List
+	start, end int
+}
+
+func (l subList) Len() int { return l.end - l.start }
+
+func (l subList) Get(i int) protoreflect.Value { return l.List.Get(l.start + i) }
+
+// sliceList removes the elements of the list outside of the range, so that the indices of the
+// elements in the range shift to begin at zero. It calls keep with the original index of each
+// element in the range, and invokes the OnClear callback for each element that's removed.
+func (s *settings) sliceList(list protoreflect.List, fd protoreflect.FieldDescriptor, start, end int, path string, keep func(i int, v protoreflect.Value)) {
+	for i := 0; i < start; i++ {
+		s.cleared(path, strconv.Itoa(i), fd)
+	}
+	for i := start; i < end; i++ {
+		v := list.Get(i)
+		if keep != nil {
+			keep(i, v)
+		}
+		list.Set(i-start, v)
+	}
+	for i, n := end, list.Len(); i < n; i++ {
+		s.cleared(path, strconv.Itoa(i), fd)
+	}
+	list.Truncate(end - start)
+}
+
+// updateSlice replaces the elements of the destination list selected by the slice with the
+// elements of the source list selected by the slice, converted by the clone function,
+// regardless of the UpdateRepeated mode.
+// A nil source list is treated as an empty list.
+func (s *settings) updateSlice(parent protoreflect.Message, fd protoreflect.FieldDescriptor, src protoreflect.List, slice listSlice, clone func(protoreflect.Value) protoreflect.Value, c *callState) {
+	if src == nil && !parent.Has(fd) {
+		return // Nothing to replace
+	}
+	dst := parent.Mutable(fd).List()
+	dstStart, dstEnd := slice.bounds(dst.Len())
+	var tail []protoreflect.Value
+	for i, n := dstEnd, dst.Len(); i < n; i++ {
+		tail = append(tail, dst.Get(i))
+	}
+	dst.Truncate(dstStart)
+	if src != nil {
+		for i, end := slice.bounds(src.Len()); i < end; i++ {
+			c.check()
+			dst.Append(clone(src.Get(i)))
+		}
+	}
+	for _, v := range tail {
+		dst.Append(v)
+	}
+	s.clearEmptyList(parent, fd, c)
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The paths of the dynamic structure of google.protobuf.Struct, Value, and ListValue messages name
+// the keys and indices of the structure rather than the fields of the messages. A path into a
+// Struct names a key of its fields, a path into a Value descends into its struct value, and a path
+// into a ListValue names an index of its values or "*" for every value. For example, the path
+// "metadata.labels.env" of a Struct field named metadata selects the env key of its labels key,
+// just like "metadata.fields.labels.struct_value.fields.env" would without the special handling.
+
+// structFieldName returns the name of the field into which the paths of the message descend
+// if it's a google.protobuf.Struct, Value, or ListValue, or else an empty name.
+func structFieldName(desc protoreflect.MessageDescriptor) protoreflect.Name {
+	switch desc.FullName() {
+	case "google.protobuf.Struct":
+		return "fields"
+	case "google.protobuf.Value":
+		return "struct_value"
+	case "google.protobuf.ListValue":
+		return "values"
+	default:
+		return ""
+	}
+}
+
+// expandStructPath returns the path of the message's fields that's named by the path of
+// its dynamic structure, if it's a google.protobuf.Struct, Value, or ListValue.
+func (mm *msgMask) expandStructPath(path string) string {
+	return mm.settings.expandStructPath(mm.desc, path)
+}
+
+// expandStructPath returns the path of the message's fields that's named by the path of
+// its dynamic structure, if it's a google.protobuf.Struct, Value, or ListValue.
+func (s *settings) expandStructPath(desc protoreflect.MessageDescriptor, path string) string {
+	if path == "" || path == "*" {
+		return path
+	}
+	name := structFieldName(desc)
+	if name == "" {
+		return path
+	}
+	return joinPath(s.fieldKey(desc.Fields().ByName(name)), path, s.pathSep)
+}
+
+// collapseStructPaths returns the paths of the dynamic structure of the message that are
+// named by the paths of its fields, if it's a google.protobuf.Struct, Value, or ListValue.
+func (mm *msgMask) collapseStructPaths(paths []string) []string {
+	fd := mm.structField()
+	if fd == nil {
+		return paths
+	}
+	key := mm.settings.fieldKey(fd)
+	prefix := joinPath(key, "", mm.settings.pathSep)
+	for i, path := range paths {
+		if strings.HasPrefix(path, key+"[") {
+			paths[i] = path[len(key):] // A list slice of a ListValue.
+			continue
+		}
+		paths[i] = strings.TrimPrefix(path, prefix)
+	}
+	return paths
+}
+
+// structField returns the descriptor of the field into which the paths of the message descend
+// if it's a google.protobuf.Struct, Value, or ListValue, or else nil.
+func (mm *msgMask) structField() protoreflect.FieldDescriptor {
+	name := structFieldName(mm.desc)
+	if name == "" {
+		return nil
+	}
+	return mm.fldDescs.ByName(name)
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2024 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fieldmask
+
+import (
+	"testing"
+
+	"bursavich.dev/fieldmask/internal/testpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		t.Fatalf("Failed to create struct: %v", err)
+	}
+	return s
+}
+
+func newValue(t *testing.T, v any) *structpb.Value {
+	t.Helper()
+	val, err := structpb.NewValue(v)
+	if err != nil {
+		t.Fatalf("Failed to create value: %v", err)
+	}
+	return val
+}
+
+func TestStruct(t *testing.T) {
+	msg := &testpb.Message{
+		Int32Field: 1,
+		StructField: newStruct(t, map[string]any{
+			"labels": map[string]any{"env": "prod", "team": "infra"},
+			"size":   3,
+		}),
+		ValueField: newValue(t, map[string]any{"a": 1, "b": map[string]any{"c": true, "d": false}}),
+		ListValueField: newStruct(t, map[string]any{"list": []any{
+			map[string]any{"name": "x", "id": 1},
+			map[string]any{"name": "y", "id": 2},
+		}}).Fields["list"].GetListValue(),
+	}
+	basicTest{
+		mask:  "struct_field.labels.env",
+		paths: []string{"struct_field.labels.env"},
+		msg:   msg,
+		out: &testpb.Message{
+			StructField: newStruct(t, map[string]any{
+				"labels": map[string]any{"env": "prod"},
+			}),
+		},
+	}.run(t)
+	basicTest{
+		mask:  "int32_field,struct_field.size,struct_field.labels",
+		paths: []string{"int32_field", "struct_field.labels", "struct_field.size"},
+		msg:   msg,
+		out: &testpb.Message{
+			Int32Field: 1,
+			StructField: newStruct(t, map[string]any{
+				"labels": map[string]any{"env": "prod", "team": "infra"},
+				"size":   3,
+			}),
+		},
+	}.run(t)
+	basicTest{
+		mask:  "value_field.a,value_field.b.c",
+		paths: []string{"value_field.a", "value_field.b.c"},
+		msg:   msg,
+		out: &testpb.Message{
+			ValueField: newValue(t, map[string]any{"a": 1, "b": map[string]any{"c": true}}),
+		},
+	}.run(t)
+	basicTest{
+		mask:  "list_value_field.*.name",
+		paths: []string{"list_value_field.*.name"},
+		msg:   msg,
+		out: &testpb.Message{
+			ListValueField: newStruct(t, map[string]any{"list": []any{
+				map[string]any{"name": "x"},
+				map[string]any{"name": "y"},
+			}}).Fields["list"].GetListValue(),
+		},
+	}.run(t)
+	basicTest{
+		mask:  "list_value_field.1.id",
+		paths: []string{"list_value_field.1.id"},
+		msg:   msg,
+		out: &testpb.Message{
+			ListValueField: &structpb.ListValue{Values: []*structpb.Value{
+				{}, // Unselected elements before a selected index are cleared.
+				newValue(t, map[string]any{"id": 2}),
+			}},
+		},
+	}.run(t)
+	basicTest{
+		mask: "list_value_field.name",
+		err:  true,
+	}.run(t)
+
+	updateTest{
+		mask: "struct_field.labels.env,struct_field.size",
+		dst: &testpb.Message{
+			StructField: newStruct(t, map[string]any{
+				"labels": map[string]any{"env": "dev", "team": "web"},
+				"size":   1,
+				"other":  "kept",
+			}),
+		},
+		src: msg,
+		out: &testpb.Message{
+			StructField: newStruct(t, map[string]any{
+				"labels": map[string]any{"env": "prod", "team": "web"},
+				"size":   3,
+				"other":  "kept",
+			}),
+		},
+	}.run(t)
+	updateTest{
+		name: "delete",
+		mask: "struct_field.labels.team",
+		dst:  msg,
+		src: &testpb.Message{
+			StructField: newStruct(t, map[string]any{
+				"labels": map[string]any{},
+			}),
+		},
+		out: func() *testpb.Message {
+			out := clone(msg)
+			delete(out.StructField.Fields["labels"].GetStructValue().Fields, "team")
+			return out
+		}(),
+	}.run(t)
+}